@@ -4,6 +4,7 @@ import (
 	"os"
 
 	"github.com/alice/checkers/app"
+	"github.com/alice/checkers/x/checkers/client/cli"
 	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
 	"github.com/ignite-hq/cli/ignite/pkg/cosmoscmd"
 )
@@ -16,6 +17,7 @@ func main() {
 		app.Name,
 		app.ModuleBasics,
 		app.New,
+		cosmoscmd.AddSubCmd(cli.CmdEngine(), cli.CmdBot()),
 		// this line is used by starport scaffolding # root/arguments
 	)
 	if err := svrcmd.Execute(rootCmd, app.DefaultNodeHome); err != nil {
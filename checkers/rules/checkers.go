@@ -11,6 +11,7 @@ const (
 	BOARD_DIM = 8
 	RED       = "red"
 	BLACK     = "black"
+	DRAW      = "draw"
 	ROW_SEP   = "|"
 )
 
@@ -27,6 +28,7 @@ var PieceStrings = map[Player]string{
 	RED_PLAYER:   "r",
 	BLACK_PLAYER: "b",
 	NO_PLAYER:    "*",
+	DRAW_PLAYER:  "d",
 }
 
 var NO_PIECE = Piece{NO_PLAYER, false}
@@ -52,6 +54,9 @@ var NO_PLAYER = Player{
 	Color: "NO_PLAYER",
 }
 
+// DRAW_PLAYER is not a side of the board, it stands in as the winner of a drawn game.
+var DRAW_PLAYER = Player{DRAW}
+
 var Players = map[string]Player{
 	RED:   RED_PLAYER,
 	BLACK: BLACK_PLAYER,
@@ -62,77 +67,58 @@ var Opponents = map[Player]Player{
 	RED_PLAYER:   BLACK_PLAYER,
 }
 
-var Usable = map[Pos]bool{}
-var Moves = map[Player]map[Pos]map[Pos]bool{}
-var Jumps = map[Player]map[Pos]map[Pos]Pos{}
-var KingMoves = map[Pos]map[Pos]bool{}
-var KingJumps = map[Pos]map[Pos]Pos{}
+// The four diagonal directions a piece can go along.
+var diagonals = []Pos{{1, 1}, {-1, 1}, {1, -1}, {-1, -1}}
 
-func Capture(src, dst Pos) Pos {
-	return Pos{(src.X + dst.X) / 2, (src.Y + dst.Y) / 2}
+// Black starts at the top and goes down the board, red starts at the bottom and goes up.
+var Forward = map[Player]int{
+	BLACK_PLAYER: 1,
+	RED_PLAYER:   -1,
 }
 
-func init() {
-
-	// Initialize usable spaces
-	for y := 0; y < BOARD_DIM; y++ {
-		for x := (y + 1) % 2; x < BOARD_DIM; x += 2 {
-			Usable[Pos{X: x, Y: y}] = true
-		}
-	}
-
-	// Initialize deep maps
-	for _, p := range Players {
-		Moves[p] = map[Pos]map[Pos]bool{}
-		Jumps[p] = map[Pos]map[Pos]Pos{}
-	}
-
-	// Compute possible moves, jumps and captures
-	for pos := range Usable {
-		KingMoves[pos] = map[Pos]bool{}
-		KingJumps[pos] = map[Pos]Pos{}
-		var directions = []int{1, -1}
-		for i, player := range []Player{BLACK_PLAYER, RED_PLAYER} {
-			Moves[player][pos] = map[Pos]bool{}
-			Jumps[player][pos] = map[Pos]Pos{}
-			movOff := 1
-			jmpOff := 2
-			for _, direction := range directions {
-				mov := Pos{pos.X + (movOff * direction), pos.Y + (movOff * directions[i])}
-				if Usable[mov] {
-					Moves[player][pos][mov] = true
-					KingMoves[pos][mov] = true
-				}
-				jmp := Pos{pos.X + (jmpOff * direction), pos.Y + (jmpOff * directions[i])}
-				if Usable[jmp] {
-					capturePos := Capture(pos, jmp)
-					Jumps[player][pos][jmp] = capturePos
-					KingJumps[pos][jmp] = capturePos
-				}
-			}
-		}
-	}
+func (pos Pos) step(direction Pos) Pos {
+	return Pos{pos.X + direction.X, pos.Y + direction.Y}
 }
 
 type Game struct {
 	Pieces map[Pos]Piece
 	Turn   Player
+	// Positions reached since the last capture or man move, oldest first.
+	// Such moves cannot be undone, so earlier positions can never come back.
+	History []string
+	// Moves played by either side since the last capture or man move. A multi-jump counts as one move.
+	MovesWithoutProgress int
+	Variant              *Variant
+	// The piece in the middle of a multi-jump, which alone can move until it has finished capturing.
+	Capturing *Pos
+	// The squares of the pieces it captured so far. They are off the board, but the rest of the
+	// multi-jump can neither cross nor capture them again.
+	Captured []Pos
 }
 
+// How many times the same position has to be reached for the game to be drawn.
+const REPETITIONS_FOR_DRAW = 3
+
+// New starts a game of American checkers.
 func New() *Game {
-	pieces := make(map[Pos]Piece)
-	game := &Game{pieces, BLACK_PLAYER}
-	game.addInitialPieces()
-	return game
+	return AMERICAN_VARIANT.New()
 }
 
 func (game *Game) addInitialPieces() {
-	for pos := range Usable {
-		if pos.Y >= 0 && pos.Y < 3 {
-			game.Pieces[pos] = Piece{BLACK_PLAYER, false}
-		}
-		if pos.Y >= BOARD_DIM-3 && pos.Y < BOARD_DIM {
-			game.Pieces[pos] = Piece{RED_PLAYER, false}
+	dim := game.Variant.BoardDim
+	rows := game.Variant.startingRows()
+	for y := 0; y < dim; y++ {
+		for x := 0; x < dim; x++ {
+			pos := Pos{x, y}
+			if !game.Variant.IsUsable(pos) {
+				continue
+			}
+			if y < rows {
+				game.Pieces[pos] = Piece{BLACK_PLAYER, false}
+			}
+			if y >= dim-rows {
+				game.Pieces[pos] = Piece{RED_PLAYER, false}
+			}
 		}
 	}
 }
@@ -142,6 +128,19 @@ func (game *Game) PieceAt(pos Pos) bool {
 	return ok
 }
 
+// occupied tells whether a piece, or one captured earlier in the multi-jump, is on the square.
+func (game *Game) occupied(pos Pos) bool {
+	if game.PieceAt(pos) {
+		return true
+	}
+	for _, captured := range game.Captured {
+		if captured == pos {
+			return true
+		}
+	}
+	return false
+}
+
 func (game *Game) TurnIs(player Player) bool {
 	return game.Turn == player
 }
@@ -165,29 +164,172 @@ func (game *Game) Winner() Player {
 	return NO_PLAYER
 }
 
-func (game *Game) ValidMove(src, dst Pos) bool {
-	if !game.PieceAt(src) || game.PieceAt(dst) {
+// Status tells who has won the game, or NO_PLAYER while it goes on. The side to move loses
+// when it has no legal move left, which includes having no pieces left.
+func (game *Game) Status() Player {
+	if !game.playerHasMove(game.Turn) {
+		return Opponents[game.Turn]
+	}
+	return NO_PLAYER
+}
+
+// Position identifies the board together with the player whose turn it is.
+func (game *Game) Position() string {
+	return game.String() + ROW_SEP + PieceStrings[game.Turn]
+}
+
+// Repetitions counts how many times the current position has been reached.
+func (game *Game) Repetitions() int {
+	position := game.Position()
+	count := 0
+	for _, seen := range game.History {
+		if seen == position {
+			count += 1
+		}
+	}
+	return count
+}
+
+// IsDraw tells whether the current position has been repeated often enough, or whether each
+// side has played maxMovesWithoutProgress moves without a capture or a man moving.
+func (game *Game) IsDraw(maxMovesWithoutProgress int) bool {
+	if game.Status() != NO_PLAYER {
 		return false
 	}
+	return REPETITIONS_FOR_DRAW <= game.Repetitions() ||
+		(0 < maxMovesWithoutProgress && 2*maxMovesWithoutProgress <= game.MovesWithoutProgress)
+}
+
+// stepsFrom lists the empty squares the piece at src can move to without capturing.
+func (game *Game) stepsFrom(src Pos) []Pos {
+	piece := game.Pieces[src]
+	flying := piece.King && game.Variant.FlyingKings
+	steps := []Pos{}
+	for _, direction := range diagonals {
+		if !piece.King && direction.Y != Forward[piece.Player] {
+			continue
+		}
+		for dst := src.step(direction); game.Variant.IsUsable(dst) && !game.occupied(dst); dst = dst.step(direction) {
+			steps = append(steps, dst)
+			if !flying {
+				break
+			}
+		}
+	}
+	return steps
+}
+
+// jumpsFrom maps the squares the piece at src can land on by capturing to the position it captures.
+func (game *Game) jumpsFrom(src Pos) map[Pos]Pos {
 	piece := game.Pieces[src]
-	if (!piece.King && Moves[piece.Player][src][dst]) || (piece.King && KingMoves[src][dst]) {
-		return !game.playerHasJump(piece.Player)
+	flying := piece.King && game.Variant.FlyingKings
+	jumps := map[Pos]Pos{}
+	for _, direction := range diagonals {
+		if !piece.King && !game.Variant.MenCaptureBackwards && direction.Y != Forward[piece.Player] {
+			continue
+		}
+		over := src.step(direction)
+		for flying && game.Variant.IsUsable(over) && !game.occupied(over) {
+			over = over.step(direction)
+		}
+		if !game.Variant.IsUsable(over) || !game.PieceAt(over) || game.Pieces[over].Player != Opponents[piece.Player] {
+			continue
+		}
+		if !piece.King && game.Pieces[over].King && game.Variant.MenCannotCaptureKings {
+			continue
+		}
+		for dst := over.step(direction); game.Variant.IsUsable(dst) && !game.occupied(dst); dst = dst.step(direction) {
+			jumps[dst] = over
+			if !flying {
+				break
+			}
+		}
+	}
+	return jumps
+}
+
+func (game *Game) isStep(src, dst Pos) bool {
+	for _, step := range game.stepsFrom(src) {
+		if step == dst {
+			return true
+		}
+	}
+	return false
+}
+
+func (game *Game) ValidMove(src, dst Pos) bool {
+	if !game.PieceAt(src) || game.occupied(dst) {
+		return false
+	}
+	if game.isStep(src, dst) {
+		return !game.playerHasJump(game.Pieces[src].Player)
 	}
 	return game.ValidJump(src, dst)
 }
 
 func (game *Game) ValidJump(src, dst Pos) bool {
-	if !game.PieceAt(src) || game.PieceAt(dst) {
+	if !game.PieceAt(src) || game.occupied(dst) {
 		return false
 	}
-	piece := game.Pieces[src]
-	if !piece.King {
-		capLoc, jumpOk := Jumps[piece.Player][src][dst]
-		return jumpOk && game.PieceAt(capLoc) && game.Pieces[capLoc].Player == Opponents[piece.Player]
-	} else {
-		capLoc, kingJumpOk := KingJumps[src][dst]
-		return kingJumpOk && game.PieceAt(capLoc) && game.Pieces[capLoc].Player == Opponents[piece.Player]
+	if _, jumpOk := game.jumpsFrom(src)[dst]; !jumpOk {
+		return false
 	}
+	if game.Capturing != nil && *game.Capturing != src {
+		return false
+	}
+	if game.Variant.MajorityCapture {
+		// Once a piece has started capturing, only its own captures are left to compare.
+		longest := game.longestCaptureFrom(src)
+		if game.Capturing == nil {
+			longest = game.longestCapture(game.Pieces[src].Player)
+		}
+		return game.captureLength(src, dst) == longest
+	}
+	return true
+}
+
+// captureLength counts the pieces captured by jumping from src to dst, then going on
+// capturing as much as possible with the same piece.
+func (game *Game) captureLength(src, dst Pos) int {
+	next := game.Clone()
+	over := next.jumpsFrom(src)[dst]
+	delete(next.Pieces, over)
+	next.Captured = append(next.Captured, over)
+	next.Pieces[dst] = next.Pieces[src]
+	delete(next.Pieces, src)
+	if next.Variant.CrownMidCapture {
+		next.kingPiece(dst)
+	}
+	longest := 0
+	for further := range next.jumpsFrom(dst) {
+		if length := next.captureLength(dst, further); longest < length {
+			longest = length
+		}
+	}
+	return 1 + longest
+}
+
+func (game *Game) longestCapture(player Player) int {
+	longest := 0
+	for src, piece := range game.Pieces {
+		if piece.Player != player {
+			continue
+		}
+		if length := game.longestCaptureFrom(src); longest < length {
+			longest = length
+		}
+	}
+	return longest
+}
+
+func (game *Game) longestCaptureFrom(src Pos) int {
+	longest := 0
+	for dst := range game.jumpsFrom(src) {
+		if length := game.captureLength(src, dst); longest < length {
+			longest = length
+		}
+	}
+	return longest
 }
 
 func (game *Game) kingPiece(dst Pos) {
@@ -195,17 +337,19 @@ func (game *Game) kingPiece(dst Pos) {
 		return
 	}
 	piece := game.Pieces[dst]
-	if (dst.Y == 0 && piece.Player == RED_PLAYER) ||
-		(dst.Y == BOARD_DIM-1 && piece.Player == BLACK_PLAYER) {
+	if dst.Y == game.Variant.kingRow(piece.Player) {
 		piece.King = true
 		game.Pieces[dst] = piece
 	}
 }
 
 func (game *Game) updateTurn(dst Pos, jumped bool) {
-	opponent := Opponents[game.Turn]
-	if (!jumped || !game.jumpPossibleFrom(dst)) && game.playerHasMove(opponent) {
-		game.Turn = opponent
+	if jumped && game.jumpPossibleFrom(dst) {
+		game.Capturing = &dst
+	} else {
+		game.Capturing = nil
+		game.Captured = nil
+		game.Turn = Opponents[game.Turn]
 	}
 }
 
@@ -213,44 +357,14 @@ func (game *Game) jumpPossibleFrom(src Pos) bool {
 	if !game.PieceAt(src) {
 		return false
 	}
-	piece := game.Pieces[src]
-	if !piece.King {
-		// enumerate all player jumps and return true if one is valid
-		for dst := range Jumps[piece.Player][src] {
-			if game.ValidJump(src, dst) {
-				return true
-			}
-		}
-	} else {
-		// enumerate all king jumps and return true if one is valid
-		for dst := range KingJumps[src] {
-			if game.ValidJump(src, dst) {
-				return true
-			}
-		}
-	}
-	return false
+	return 0 < len(game.jumpsFrom(src))
 }
 
 func (game *Game) movePossibleFrom(src Pos) bool {
 	if !game.PieceAt(src) {
 		return false
 	}
-	piece := game.Pieces[src]
-	if !piece.King {
-		for dst := range Moves[piece.Player][src] {
-			if game.ValidMove(src, dst) {
-				return true
-			}
-		}
-	} else {
-		for dst := range KingMoves[src] {
-			if game.ValidMove(src, dst) {
-				return true
-			}
-		}
-	}
-	return false
+	return 0 < len(game.stepsFrom(src))
 }
 
 func (game *Game) playerHasMove(player Player) bool {
@@ -277,7 +391,7 @@ func (game *Game) Move(src, dst Pos) (captured Pos, err error) {
 	if !game.PieceAt(src) {
 		return NO_POS, errors.New(fmt.Sprintf("No piece at source position: %v", src))
 	}
-	if game.PieceAt(dst) {
+	if game.occupied(dst) {
 		return NO_POS, errors.New(fmt.Sprintf("Already piece at destination position: %v", dst))
 	}
 	if !game.TurnIs(game.Pieces[src].Player) {
@@ -286,24 +400,68 @@ func (game *Game) Move(src, dst Pos) (captured Pos, err error) {
 	if !game.ValidMove(src, dst) {
 		return NO_POS, errors.New(fmt.Sprintf("Invalid move: %v to %v", src, dst))
 	}
-	if game.ValidJump(src, dst) {
-		game.Pieces[dst] = game.Pieces[src]
-		delete(game.Pieces, src)
-		captured = Capture(src, dst)
+	progress := !game.Pieces[src].King
+	if jumpedOver, isJump := game.jumpsFrom(src)[dst]; isJump {
+		progress = true
+		captured = jumpedOver
 		delete(game.Pieces, captured)
-	} else {
-		game.Pieces[dst] = game.Pieces[src]
-		delete(game.Pieces, src)
+		game.Captured = append(game.Captured, captured)
+	}
+	player := game.Pieces[src].Player
+	game.Pieces[dst] = game.Pieces[src]
+	delete(game.Pieces, src)
+	if game.Variant.CrownMidCapture {
+		game.kingPiece(dst)
 	}
 	game.updateTurn(dst, captured != NO_POS)
-	game.kingPiece(dst)
+	// A man that still has to capture is crowned at the end of its turn, if it stops on the far row.
+	// Every hop of a multi-jump is a capture, so the move is only recorded once the turn is over.
+	if !game.TurnIs(player) {
+		game.kingPiece(dst)
+		game.recordPosition(progress)
+	}
 	return
 }
 
+// MoveAlong moves the piece at path[0] through every following position in turn, as
+// a simple move or a chain of jumps. The game is only changed if the whole path is valid.
+func (game *Game) MoveAlong(path []Pos) (captured []Pos, err error) {
+	if len(path) < 2 {
+		return nil, errors.New(fmt.Sprintf("Path too short: %v", path))
+	}
+	next := game.Clone()
+	player := next.Turn
+	for i := 1; i < len(path); i++ {
+		if 1 < i && !next.TurnIs(player) {
+			return nil, errors.New(fmt.Sprintf("Cannot keep moving after %v", path[i-1]))
+		}
+		hopCaptured, hopErr := next.Move(path[i-1], path[i])
+		if hopErr != nil {
+			return nil, hopErr
+		}
+		if hopCaptured != NO_POS {
+			captured = append(captured, hopCaptured)
+		}
+	}
+	*game = *next
+	return captured, nil
+}
+
+func (game *Game) recordPosition(progress bool) {
+	if progress {
+		game.History = nil
+		game.MovesWithoutProgress = 0
+	} else {
+		game.MovesWithoutProgress += 1
+	}
+	game.History = append(game.History, game.Position())
+}
+
 func (game *Game) String() string {
 	var buf bytes.Buffer
-	for y := 0; y < BOARD_DIM; y++ {
-		for x := 0; x < BOARD_DIM; x++ {
+	dim := game.Variant.BoardDim
+	for y := 0; y < dim; y++ {
+		for x := 0; x < dim; x++ {
 			pos := Pos{x, y}
 			if game.PieceAt(pos) {
 				piece := game.Pieces[pos]
@@ -316,7 +474,7 @@ func (game *Game) String() string {
 				buf.WriteString(PieceStrings[NO_PLAYER])
 			}
 		}
-		if y < (BOARD_DIM - 1) {
+		if y < (dim - 1) {
 			buf.WriteString(ROW_SEP)
 		}
 	}
@@ -328,15 +486,21 @@ func ParsePiece(s string) (Piece, bool) {
 	return piece, ok
 }
 
+// Parse reads a board of American checkers.
 func Parse(s string) (*Game, error) {
-	if len(s) != BOARD_DIM*BOARD_DIM+(BOARD_DIM-1) {
+	return AMERICAN_VARIANT.Parse(s)
+}
+
+func (variant *Variant) Parse(s string) (*Game, error) {
+	dim := variant.BoardDim
+	if len(s) != dim*dim+(dim-1) {
 		return nil, errors.New(fmt.Sprintf("invalid board string: %v", s))
 	}
 	pieces := make(map[Pos]Piece)
-	result := &Game{pieces, BLACK_PLAYER}
+	result := &Game{Pieces: pieces, Turn: variant.FirstPlayer, Variant: variant}
 	for y, row := range strings.Split(s, ROW_SEP) {
 		for x, c := range strings.Split(row, "") {
-			if x >= BOARD_DIM || y >= BOARD_DIM {
+			if x >= dim || y >= dim {
 				return nil, errors.New(fmt.Sprintf("invalid board, piece out of bounds: %v, %v", x, y))
 			}
 			if piece, ok := ParsePiece(c); !ok {
@@ -0,0 +1,345 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func newGame(variant *rules.Variant, turn rules.Player, pieces map[rules.Pos]rules.Piece) *rules.Game {
+	return &rules.Game{Pieces: pieces, Turn: turn, Variant: variant}
+}
+
+var (
+	redMan    = rules.Piece{Player: rules.RED_PLAYER}
+	redKing   = rules.Piece{Player: rules.RED_PLAYER, King: true}
+	blackMan  = rules.Piece{Player: rules.BLACK_PLAYER}
+	blackKing = rules.Piece{Player: rules.BLACK_PLAYER, King: true}
+)
+
+func countPieces(game *rules.Game, player rules.Player) (count int) {
+	for _, piece := range game.Pieces {
+		if piece.Player == player {
+			count += 1
+		}
+	}
+	return count
+}
+
+func destinations(moves []rules.Move) []rules.Pos {
+	dsts := []rules.Pos{}
+	for _, move := range moves {
+		dsts = append(dsts, move.Dst)
+	}
+	return dsts
+}
+
+func TestVariantsStart(t *testing.T) {
+	for _, tc := range []struct {
+		variant *rules.Variant
+		dim     int
+		pieces  int
+		first   rules.Player
+		moves   int
+	}{
+		{variant: rules.AMERICAN_VARIANT, dim: 8, pieces: 12, first: rules.BLACK_PLAYER, moves: 7},
+		{variant: rules.INTERNATIONAL_VARIANT, dim: 10, pieces: 20, first: rules.RED_PLAYER, moves: 9},
+		{variant: rules.RUSSIAN_VARIANT, dim: 8, pieces: 12, first: rules.RED_PLAYER, moves: 7},
+		{variant: rules.ITALIAN_VARIANT, dim: 8, pieces: 12, first: rules.RED_PLAYER, moves: 7},
+		{variant: rules.POOL_VARIANT, dim: 8, pieces: 12, first: rules.BLACK_PLAYER, moves: 7},
+	} {
+		t.Run(tc.variant.Name, func(t *testing.T) {
+			game := tc.variant.New()
+			require.Equal(t, tc.dim, game.Variant.BoardDim)
+			require.Equal(t, tc.pieces, countPieces(game, rules.BLACK_PLAYER))
+			require.Equal(t, tc.pieces, countPieces(game, rules.RED_PLAYER))
+			require.True(t, game.TurnIs(tc.first))
+			require.Len(t, game.LegalMoves(tc.first), tc.moves)
+			require.Empty(t, game.LegalMoves(rules.Opponents[tc.first]))
+			require.Equal(t, rules.NO_PLAYER, game.Status())
+		})
+	}
+}
+
+func TestVariantsByName(t *testing.T) {
+	require.Equal(t, rules.AMERICAN_VARIANT, rules.Variants[""])
+	for _, variant := range []*rules.Variant{
+		rules.AMERICAN_VARIANT,
+		rules.INTERNATIONAL_VARIANT,
+		rules.RUSSIAN_VARIANT,
+		rules.ITALIAN_VARIANT,
+		rules.POOL_VARIANT,
+	} {
+		require.Equal(t, variant, rules.Variants[variant.Name])
+	}
+}
+
+func TestManStepsForwardOnly(t *testing.T) {
+	for _, variant := range []*rules.Variant{rules.AMERICAN_VARIANT, rules.INTERNATIONAL_VARIANT, rules.RUSSIAN_VARIANT} {
+		t.Run(variant.Name, func(t *testing.T) {
+			game := newGame(variant, rules.RED_PLAYER, map[rules.Pos]rules.Piece{
+				{X: 3, Y: 4}: redMan,
+				{X: 0, Y: 1}: blackMan,
+			})
+			require.Equal(t, []rules.Pos{{X: 2, Y: 3}, {X: 4, Y: 3}}, destinations(game.LegalMoves(rules.RED_PLAYER)))
+			_, err := game.Move(rules.Pos{X: 3, Y: 4}, rules.Pos{X: 4, Y: 5})
+			require.EqualError(t, err, "Invalid move: {3 4} to {4 5}")
+		})
+	}
+}
+
+func TestManCapturesBackwards(t *testing.T) {
+	for _, tc := range []struct {
+		variant   *rules.Variant
+		backwards bool
+	}{
+		{variant: rules.AMERICAN_VARIANT, backwards: false},
+		{variant: rules.INTERNATIONAL_VARIANT, backwards: true},
+		{variant: rules.RUSSIAN_VARIANT, backwards: true},
+		{variant: rules.ITALIAN_VARIANT, backwards: false},
+		{variant: rules.POOL_VARIANT, backwards: true},
+	} {
+		t.Run(tc.variant.Name, func(t *testing.T) {
+			game := newGame(tc.variant, rules.RED_PLAYER, map[rules.Pos]rules.Piece{
+				{X: 3, Y: 4}: redMan,
+				{X: 4, Y: 5}: blackMan,
+			})
+			moves := game.LegalMoves(rules.RED_PLAYER)
+			if tc.backwards {
+				require.Equal(t, []rules.Move{
+					{Src: rules.Pos{X: 3, Y: 4}, Dst: rules.Pos{X: 5, Y: 6}, Captured: rules.Pos{X: 4, Y: 5}},
+				}, moves)
+			} else {
+				require.Equal(t, []rules.Pos{{X: 2, Y: 3}, {X: 4, Y: 3}}, destinations(moves))
+			}
+		})
+	}
+}
+
+func TestKingSteps(t *testing.T) {
+	for _, tc := range []struct {
+		variant *rules.Variant
+		steps   int
+	}{
+		{variant: rules.AMERICAN_VARIANT, steps: 1},
+		{variant: rules.INTERNATIONAL_VARIANT, steps: 9},
+		{variant: rules.RUSSIAN_VARIANT, steps: 7},
+		{variant: rules.ITALIAN_VARIANT, steps: 1},
+		{variant: rules.POOL_VARIANT, steps: 7},
+	} {
+		t.Run(tc.variant.Name, func(t *testing.T) {
+			game := newGame(tc.variant, rules.RED_PLAYER, map[rules.Pos]rules.Piece{
+				{X: 0, Y: 7}: redKing,
+				{X: 3, Y: 0}: blackMan,
+			})
+			require.Len(t, game.LegalMoves(rules.RED_PLAYER), tc.steps)
+		})
+	}
+}
+
+func TestFlyingKingCapturesFromAfar(t *testing.T) {
+	for _, tc := range []struct {
+		variant *rules.Variant
+		dsts    []rules.Pos
+	}{
+		{variant: rules.AMERICAN_VARIANT, dsts: []rules.Pos{{X: 1, Y: 6}}},
+		{variant: rules.RUSSIAN_VARIANT, dsts: []rules.Pos{{X: 7, Y: 0}, {X: 6, Y: 1}}},
+	} {
+		t.Run(tc.variant.Name, func(t *testing.T) {
+			game := newGame(tc.variant, rules.RED_PLAYER, map[rules.Pos]rules.Piece{
+				{X: 0, Y: 7}: redKing,
+				{X: 5, Y: 2}: blackMan,
+			})
+			require.Equal(t, tc.dsts, destinations(game.LegalMoves(rules.RED_PLAYER)))
+		})
+	}
+}
+
+// A red man can capture two pieces, another only one.
+func majorityPosition(variant *rules.Variant) *rules.Game {
+	return newGame(variant, rules.RED_PLAYER, map[rules.Pos]rules.Piece{
+		{X: 1, Y: 6}: redMan,
+		{X: 2, Y: 5}: blackMan,
+		{X: 2, Y: 3}: blackMan,
+		{X: 7, Y: 6}: redMan,
+		{X: 6, Y: 5}: blackMan,
+	})
+}
+
+func TestMajorityCapture(t *testing.T) {
+	longest := rules.Move{
+		Src:      rules.Pos{X: 1, Y: 6},
+		Dst:      rules.Pos{X: 3, Y: 4},
+		Captured: rules.Pos{X: 2, Y: 5},
+		Then: []rules.Move{
+			{Src: rules.Pos{X: 3, Y: 4}, Dst: rules.Pos{X: 1, Y: 2}, Captured: rules.Pos{X: 2, Y: 3}},
+		},
+	}
+	shortest := rules.Move{Src: rules.Pos{X: 7, Y: 6}, Dst: rules.Pos{X: 5, Y: 4}, Captured: rules.Pos{X: 6, Y: 5}}
+	for _, tc := range []struct {
+		variant *rules.Variant
+		moves   []rules.Move
+	}{
+		{variant: rules.AMERICAN_VARIANT, moves: []rules.Move{longest, shortest}},
+		{variant: rules.INTERNATIONAL_VARIANT, moves: []rules.Move{longest}},
+		{variant: rules.RUSSIAN_VARIANT, moves: []rules.Move{longest, shortest}},
+		{variant: rules.ITALIAN_VARIANT, moves: []rules.Move{longest}},
+		{variant: rules.POOL_VARIANT, moves: []rules.Move{longest, shortest}},
+	} {
+		t.Run(tc.variant.Name, func(t *testing.T) {
+			game := majorityPosition(tc.variant)
+			require.Equal(t, tc.moves, game.LegalMoves(rules.RED_PLAYER))
+			require.Equal(t, tc.variant.MajorityCapture, !game.ValidMove(rules.Pos{X: 7, Y: 6}, rules.Pos{X: 5, Y: 4}))
+		})
+	}
+}
+
+func TestForcedCapture(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		src  rules.Pos
+		dst  rules.Pos
+		err  string
+	}{
+		{name: "step with capturing piece", src: rules.Pos{X: 1, Y: 6}, dst: rules.Pos{X: 0, Y: 5}, err: "Invalid move: {1 6} to {0 5}"},
+		{name: "step onto a piece", src: rules.Pos{X: 7, Y: 6}, dst: rules.Pos{X: 6, Y: 5}, err: "Already piece at destination position: {6 5}"},
+		{name: "longer capture", src: rules.Pos{X: 1, Y: 6}, dst: rules.Pos{X: 3, Y: 4}},
+		{name: "shorter capture", src: rules.Pos{X: 7, Y: 6}, dst: rules.Pos{X: 5, Y: 4}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			game := majorityPosition(rules.AMERICAN_VARIANT)
+			_, err := game.Move(tc.src, tc.dst)
+			if tc.err == "" {
+				require.Nil(t, err)
+			} else {
+				require.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestForcedCaptureLeavesOnlyJumps(t *testing.T) {
+	game := newGame(rules.AMERICAN_VARIANT, rules.RED_PLAYER, map[rules.Pos]rules.Piece{
+		{X: 1, Y: 6}: redMan,
+		{X: 2, Y: 5}: blackMan,
+		{X: 5, Y: 6}: redMan,
+	})
+	require.Equal(t, []rules.Move{
+		{Src: rules.Pos{X: 1, Y: 6}, Dst: rules.Pos{X: 3, Y: 4}, Captured: rules.Pos{X: 2, Y: 5}},
+	}, game.LegalMoves(rules.RED_PLAYER))
+	require.Empty(t, game.LegalMovesFrom(rules.Pos{X: 5, Y: 6}))
+	_, err := game.Move(rules.Pos{X: 5, Y: 6}, rules.Pos{X: 4, Y: 5})
+	require.EqualError(t, err, "Invalid move: {5 6} to {4 5}")
+}
+
+func TestMultiJumpKeepsTurn(t *testing.T) {
+	game := majorityPosition(rules.AMERICAN_VARIANT)
+	captured, err := game.MoveAlong([]rules.Pos{{X: 1, Y: 6}, {X: 3, Y: 4}, {X: 1, Y: 2}})
+	require.Nil(t, err)
+	require.Equal(t, []rules.Pos{{X: 2, Y: 5}, {X: 2, Y: 3}}, captured)
+	require.True(t, game.TurnIs(rules.BLACK_PLAYER))
+
+	game = majorityPosition(rules.AMERICAN_VARIANT)
+	_, err = game.MoveAlong([]rules.Pos{{X: 7, Y: 6}, {X: 5, Y: 4}, {X: 3, Y: 2}})
+	require.EqualError(t, err, "Cannot keep moving after {5 4}")
+	require.Equal(t, majorityPosition(rules.AMERICAN_VARIANT), game)
+
+	_, err = game.MoveAlong([]rules.Pos{{X: 1, Y: 6}})
+	require.EqualError(t, err, "Path too short: [{1 6}]")
+}
+
+func TestStatusNoMoveLeft(t *testing.T) {
+	for _, variant := range []*rules.Variant{rules.AMERICAN_VARIANT, rules.INTERNATIONAL_VARIANT, rules.RUSSIAN_VARIANT} {
+		t.Run(variant.Name, func(t *testing.T) {
+			game := newGame(variant, rules.RED_PLAYER, map[rules.Pos]rules.Piece{
+				{X: 0, Y: 3}: redMan,
+				{X: 1, Y: 2}: blackMan,
+				{X: 2, Y: 1}: blackMan,
+			})
+			require.Empty(t, game.LegalMoves(rules.RED_PLAYER))
+			require.Equal(t, rules.BLACK_PLAYER, game.Status())
+			require.False(t, game.IsDraw(0))
+		})
+	}
+}
+
+func TestStatusNoPieceLeft(t *testing.T) {
+	game := newGame(rules.AMERICAN_VARIANT, rules.RED_PLAYER, map[rules.Pos]rules.Piece{
+		{X: 3, Y: 4}: redMan,
+		{X: 2, Y: 3}: blackMan,
+	})
+	_, err := game.Move(rules.Pos{X: 3, Y: 4}, rules.Pos{X: 1, Y: 2})
+	require.Nil(t, err)
+	require.True(t, game.TurnIs(rules.BLACK_PLAYER))
+	require.Equal(t, rules.RED_PLAYER, game.Status())
+}
+
+func TestThreefoldRepetition(t *testing.T) {
+	game := newGame(rules.AMERICAN_VARIANT, rules.RED_PLAYER, map[rules.Pos]rules.Piece{
+		{X: 0, Y: 7}: redKing,
+		{X: 7, Y: 0}: blackKing,
+	})
+	shuffle := [][]rules.Pos{
+		{{X: 0, Y: 7}, {X: 1, Y: 6}},
+		{{X: 7, Y: 0}, {X: 6, Y: 1}},
+		{{X: 1, Y: 6}, {X: 0, Y: 7}},
+		{{X: 6, Y: 1}, {X: 7, Y: 0}},
+	}
+	for _, tc := range []struct {
+		repetitions          int
+		movesWithoutProgress int
+		draw                 bool
+	}{
+		{repetitions: 1, movesWithoutProgress: 4, draw: false},
+		{repetitions: 2, movesWithoutProgress: 8, draw: false},
+		{repetitions: 3, movesWithoutProgress: 12, draw: true},
+	} {
+		for _, path := range shuffle {
+			_, err := game.MoveAlong(path)
+			require.Nil(t, err)
+		}
+		require.Equal(t, tc.repetitions, game.Repetitions())
+		require.Equal(t, tc.movesWithoutProgress, game.MovesWithoutProgress)
+		require.Equal(t, tc.draw, game.IsDraw(0))
+	}
+}
+
+func TestMovesWithoutProgress(t *testing.T) {
+	game := newGame(rules.AMERICAN_VARIANT, rules.RED_PLAYER, map[rules.Pos]rules.Piece{
+		{X: 0, Y: 7}: redKing,
+		{X: 7, Y: 0}: blackKing,
+		{X: 4, Y: 1}: blackMan,
+	})
+	_, err := game.Move(rules.Pos{X: 0, Y: 7}, rules.Pos{X: 1, Y: 6})
+	require.Nil(t, err)
+	_, err = game.Move(rules.Pos{X: 7, Y: 0}, rules.Pos{X: 6, Y: 1})
+	require.Nil(t, err)
+	// One move each, so a limit of one move per side is reached.
+	require.Equal(t, 2, game.MovesWithoutProgress)
+	require.True(t, game.IsDraw(1))
+	require.False(t, game.IsDraw(2))
+	require.False(t, game.IsDraw(0))
+
+	// A man moving is progress.
+	_, err = game.Move(rules.Pos{X: 1, Y: 6}, rules.Pos{X: 2, Y: 5})
+	require.Nil(t, err)
+	_, err = game.Move(rules.Pos{X: 4, Y: 1}, rules.Pos{X: 5, Y: 2})
+	require.Nil(t, err)
+	require.Equal(t, 0, game.MovesWithoutProgress)
+	require.Len(t, game.History, 1)
+}
+
+func TestMultiJumpRecordedOnce(t *testing.T) {
+	game := majorityPosition(rules.AMERICAN_VARIANT)
+	game.MovesWithoutProgress = 5
+	_, err := game.MoveAlong([]rules.Pos{{X: 1, Y: 6}, {X: 3, Y: 4}, {X: 1, Y: 2}})
+	require.Nil(t, err)
+	require.Equal(t, 0, game.MovesWithoutProgress)
+	require.Equal(t, []string{game.Position()}, game.History)
+
+	_, err = game.Move(rules.Pos{X: 6, Y: 5}, rules.Pos{X: 5, Y: 6})
+	require.Nil(t, err)
+	require.Equal(t, 0, game.MovesWithoutProgress)
+	require.Len(t, game.History, 1)
+}
@@ -0,0 +1,120 @@
+// Package engine plays checkers on top of the rules, by searching the moves ahead with alpha-beta
+// pruning and scoring the positions it reaches. Given the same game and settings, it always picks
+// the same move.
+package engine
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/alice/checkers/rules"
+)
+
+const (
+	DefaultDepth = 6
+	// Searching deeper than this takes too long to be of use.
+	MaxDepth = 10
+	// A won position scores this, less the turns it takes to get there so that quicker wins are
+	// preferred, and slower losses.
+	WinScore = 1_000_000
+)
+
+type Engine struct {
+	// How many turns ahead to search, a multi-jump counting as a single turn.
+	Depth   int
+	Weights Weights
+}
+
+// Suggestion is the move the engine picks, as the path of the piece for the whole turn, with the
+// score it expects for the player to move.
+type Suggestion struct {
+	Path  []rules.Pos
+	Score int
+}
+
+func New(depth int) *Engine {
+	return &Engine{Depth: depth, Weights: DefaultWeights}
+}
+
+// BestMove searches the game from the position it is in and returns the best move for the player
+// whose turn it is. Of moves that score the same, the first in the order of the legal moves wins.
+func (engine *Engine) BestMove(game *rules.Game) (suggestion Suggestion, err error) {
+	if engine.Depth < 1 || MaxDepth < engine.Depth {
+		return suggestion, errors.New(fmt.Sprintf("Depth out of range 1 to %d: %d", MaxDepth, engine.Depth))
+	}
+	paths := TurnPaths(game)
+	if len(paths) == 0 {
+		return suggestion, errors.New(fmt.Sprintf("No legal move for %v", game.Turn))
+	}
+	alpha := -WinScore - 1
+	for _, path := range paths {
+		next, err := play(game, path)
+		if err != nil {
+			return suggestion, err
+		}
+		score := -engine.search(next, engine.Depth-1, 1, -WinScore-1, -alpha)
+		if suggestion.Path == nil || alpha < score {
+			suggestion = Suggestion{Path: path, Score: score}
+			alpha = score
+		}
+	}
+	return suggestion, nil
+}
+
+// search is a negamax with alpha-beta pruning, scoring the position for the player to move.
+func (engine *Engine) search(game *rules.Game, depth int, ply int, alpha int, beta int) int {
+	if winner := game.Status(); winner != rules.NO_PLAYER {
+		// The player to move has no move left.
+		return -WinScore + ply
+	}
+	if game.IsDraw(0) {
+		return 0
+	}
+	if depth == 0 {
+		return Evaluate(game, game.Turn, engine.Weights)
+	}
+	for _, path := range TurnPaths(game) {
+		next, err := play(game, path)
+		if err != nil {
+			panic(err.Error())
+		}
+		score := -engine.search(next, depth-1, ply+1, -beta, -alpha)
+		if alpha < score {
+			alpha = score
+		}
+		if beta <= alpha {
+			break
+		}
+	}
+	return alpha
+}
+
+// TurnPaths lists the paths the player to move can take on their whole turn, following the
+// legal moves and the jumps each one has to be followed with.
+func TurnPaths(game *rules.Game) (paths [][]rules.Pos) {
+	for _, move := range game.LegalMoves(game.Turn) {
+		paths = append(paths, followPaths([]rules.Pos{move.Src}, move)...)
+	}
+	return paths
+}
+
+func followPaths(start []rules.Pos, move rules.Move) (paths [][]rules.Pos) {
+	path := make([]rules.Pos, len(start), len(start)+1)
+	copy(path, start)
+	path = append(path, move.Dst)
+	if len(move.Then) == 0 {
+		return [][]rules.Pos{path}
+	}
+	for _, then := range move.Then {
+		paths = append(paths, followPaths(path, then)...)
+	}
+	return paths
+}
+
+func play(game *rules.Game, path []rules.Pos) (next *rules.Game, err error) {
+	next = game.Clone()
+	if _, err := next.MoveAlong(path); err != nil {
+		return nil, err
+	}
+	return next, nil
+}
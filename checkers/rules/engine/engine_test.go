@@ -0,0 +1,65 @@
+package engine_test
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/rules/engine"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluateStartIsEven(t *testing.T) {
+	game := rules.New()
+	require.Equal(t, 0, engine.Evaluate(game, rules.BLACK_PLAYER, engine.DefaultWeights))
+	require.Equal(t, 0, engine.Evaluate(game, rules.RED_PLAYER, engine.DefaultWeights))
+}
+
+func TestEvaluateCountsKingsAndBackRank(t *testing.T) {
+	game, err := rules.Parse("*b******|********|********|********|********|****R***|********|********")
+	require.Nil(t, err)
+	// Black man on its back rank with 2 moves, against a red king with 4.
+	require.Equal(t, 100+10-160+2*(2-4), engine.Evaluate(game, rules.BLACK_PLAYER, engine.DefaultWeights))
+	require.Equal(t, 160-100-10+2*(4-2), engine.Evaluate(game, rules.RED_PLAYER, engine.DefaultWeights))
+}
+
+func TestTurnPathsFollowJumps(t *testing.T) {
+	game, err := rules.Parse("*b******|**r*****|********|****r***|********|********|********|********")
+	require.Nil(t, err)
+	require.Equal(t, [][]rules.Pos{{{X: 1, Y: 0}, {X: 3, Y: 2}, {X: 5, Y: 4}}}, engine.TurnPaths(game))
+}
+
+func TestBestMoveWinsAtOnce(t *testing.T) {
+	game, err := rules.Parse("*b******|**r*****|********|****r***|********|********|********|*****b**")
+	require.Nil(t, err)
+	suggestion, err := engine.New(4).BestMove(game)
+	require.Nil(t, err)
+	require.Equal(t, engine.Suggestion{
+		Path:  []rules.Pos{{X: 1, Y: 0}, {X: 3, Y: 2}, {X: 5, Y: 4}},
+		Score: engine.WinScore - 1,
+	}, suggestion)
+}
+
+func TestBestMoveIsDeterministic(t *testing.T) {
+	first, err := engine.New(4).BestMove(rules.New())
+	require.Nil(t, err)
+	for i := 0; i < 3; i++ {
+		again, err := engine.New(4).BestMove(rules.New())
+		require.Nil(t, err)
+		require.Equal(t, first, again)
+	}
+	require.Equal(t, []rules.Pos{{X: 1, Y: 2}, {X: 0, Y: 3}}, first.Path)
+}
+
+func TestBestMoveNoMove(t *testing.T) {
+	game, err := rules.Parse("********|********|********|********|********|********|********|r*******")
+	require.Nil(t, err)
+	_, err = engine.New(4).BestMove(game)
+	require.EqualError(t, err, "No legal move for {black}")
+}
+
+func TestBestMoveDepthOutOfRange(t *testing.T) {
+	_, err := engine.New(0).BestMove(rules.New())
+	require.EqualError(t, err, "Depth out of range 1 to 10: 0")
+	_, err = engine.New(engine.MaxDepth + 1).BestMove(rules.New())
+	require.EqualError(t, err, "Depth out of range 1 to 10: 11")
+}
@@ -0,0 +1,64 @@
+package engine
+
+import (
+	"github.com/alice/checkers/rules"
+)
+
+// Weights tell how much each feature of a position is worth. Scores are in hundredths of a man
+// with the default weights.
+type Weights struct {
+	Man  int
+	King int
+	// Men still on the row they started from, where they keep the opponent from crowning.
+	BackRank int
+	// Each legal move the player can make.
+	Mobility int
+}
+
+var DefaultWeights = Weights{
+	Man:      100,
+	King:     160,
+	BackRank: 10,
+	Mobility: 2,
+}
+
+// Evaluate scores the position for the player, the higher the better, by comparing their pieces
+// and moves to those of the opponent. It does not look ahead.
+func Evaluate(game *rules.Game, player rules.Player, weights Weights) (score int) {
+	opponent := rules.Opponents[player]
+	for pos, piece := range game.Pieces {
+		value := 0
+		if piece.King {
+			value += weights.King
+		} else {
+			value += weights.Man
+			if pos.Y == homeRow(game.Variant, piece.Player) {
+				value += weights.BackRank
+			}
+		}
+		if piece.Player == player {
+			score += value
+		} else if piece.Player == opponent {
+			score -= value
+		}
+	}
+	score += weights.Mobility * (mobility(game, player) - mobility(game, opponent))
+	return score
+}
+
+// The row the player's men start from, opposite the row where they are crowned.
+func homeRow(variant *rules.Variant, player rules.Player) int {
+	if player == rules.BLACK_PLAYER {
+		return 0
+	}
+	return variant.BoardDim - 1
+}
+
+// How many moves the player could make if it were their turn.
+func mobility(game *rules.Game, player rules.Player) int {
+	if !game.TurnIs(player) {
+		game = game.Clone()
+		game.Turn = player
+	}
+	return len(game.LegalMoves(player))
+}
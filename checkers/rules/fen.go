@@ -0,0 +1,138 @@
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FEN describes a position as the side to move, then the squares of each side's pieces,
+// as in "B:W21,22,K30:B1,2,K9". Squares are numbered as in PDN and kings are marked with K.
+// As in PDN, red is called White.
+const (
+	FEN_SEP        = ":"
+	FEN_SQUARE_SEP = ","
+	FEN_RANGE_SEP  = "-"
+	FEN_KING       = "K"
+)
+
+var FenPlayers = map[Player]string{
+	RED_PLAYER:   "W",
+	BLACK_PLAYER: "B",
+}
+
+var StringFenPlayers = map[string]Player{
+	"W": RED_PLAYER,
+	"B": BLACK_PLAYER,
+}
+
+// Fen renders the position and the side to move.
+func (game *Game) Fen() string {
+	squares := map[Player][]int{}
+	kings := map[int]bool{}
+	for pos, piece := range game.Pieces {
+		square := game.Variant.Square(pos)
+		squares[piece.Player] = append(squares[piece.Player], square)
+		kings[square] = piece.King
+	}
+	fields := []string{FenPlayers[game.Turn]}
+	for _, player := range []Player{RED_PLAYER, BLACK_PLAYER} {
+		sort.Ints(squares[player])
+		pieces := make([]string, len(squares[player]))
+		for i, square := range squares[player] {
+			pieces[i] = strconv.Itoa(square)
+			if kings[square] {
+				pieces[i] = FEN_KING + pieces[i]
+			}
+		}
+		fields = append(fields, FenPlayers[player]+strings.Join(pieces, FEN_SQUARE_SEP))
+	}
+	return strings.Join(fields, FEN_SEP)
+}
+
+// ParseFen reads a position of American checkers.
+func ParseFen(s string) (*Game, error) {
+	return AMERICAN_VARIANT.ParseFen(s)
+}
+
+// ParseFen reads a position, where ranges such as "1-12" may stand for several men. The
+// position has to be one that can be reached: both sides have pieces, but no more than they
+// start with, and no man stands on the row where it would have been crowned.
+func (variant *Variant) ParseFen(s string) (*Game, error) {
+	fields := strings.Split(strings.TrimSuffix(strings.TrimSpace(s), "."), FEN_SEP)
+	if len(fields) != 3 {
+		return nil, errors.New(fmt.Sprintf("invalid FEN, expected side to move and two piece lists: %v", s))
+	}
+	turn, found := StringFenPlayers[fields[0]]
+	if !found {
+		return nil, errors.New(fmt.Sprintf("invalid FEN, unknown side to move: %v", fields[0]))
+	}
+	game := &Game{Pieces: make(map[Pos]Piece), Turn: turn, Variant: variant}
+	for _, field := range fields[1:] {
+		if field == "" {
+			return nil, errors.New(fmt.Sprintf("invalid FEN, empty piece list: %v", s))
+		}
+		player, found := StringFenPlayers[field[:1]]
+		if !found {
+			return nil, errors.New(fmt.Sprintf("invalid FEN, unknown side: %v", field[:1]))
+		}
+		if err := game.addFenPieces(player, field[1:]); err != nil {
+			return nil, err
+		}
+	}
+	maxPieces := variant.startingRows() * variant.BoardDim / 2
+	for _, player := range []Player{RED_PLAYER, BLACK_PLAYER} {
+		count := game.countPieces(player)
+		if count == 0 {
+			return nil, errors.New(fmt.Sprintf("invalid FEN, %v has no piece", player.Color))
+		}
+		if maxPieces < count {
+			return nil, errors.New(fmt.Sprintf("invalid FEN, %v has more than %d pieces", player.Color, maxPieces))
+		}
+	}
+	return game, nil
+}
+
+func (game *Game) addFenPieces(player Player, list string) error {
+	if list == "" {
+		return nil
+	}
+	for _, item := range strings.Split(list, FEN_SQUARE_SEP) {
+		king := strings.HasPrefix(item, FEN_KING)
+		bounds := strings.SplitN(strings.TrimPrefix(item, FEN_KING), FEN_RANGE_SEP, 2)
+		first, errFirst := strconv.Atoi(bounds[0])
+		last, errLast := first, error(nil)
+		if len(bounds) == 2 {
+			last, errLast = strconv.Atoi(strings.TrimPrefix(bounds[1], FEN_KING))
+		}
+		if errFirst != nil || errLast != nil || last < first {
+			return errors.New(fmt.Sprintf("invalid FEN, not a square: %v", item))
+		}
+		for square := first; square <= last; square++ {
+			pos, err := game.Variant.SquarePos(square)
+			if err != nil {
+				return err
+			}
+			if game.PieceAt(pos) {
+				return errors.New(fmt.Sprintf("invalid FEN, square taken twice: %d", square))
+			}
+			if !king && pos.Y == game.Variant.kingRow(player) {
+				return errors.New(fmt.Sprintf("invalid FEN, man should have been crowned: %d", square))
+			}
+			game.Pieces[pos] = Piece{player, king}
+		}
+	}
+	return nil
+}
+
+func (game *Game) countPieces(player Player) int {
+	count := 0
+	for _, piece := range game.Pieces {
+		if piece.Player == player {
+			count += 1
+		}
+	}
+	return count
+}
@@ -0,0 +1,86 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFenStartRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		variant *rules.Variant
+		fen     string
+	}{
+		{variant: rules.AMERICAN_VARIANT, fen: "B:W21-32:B1-12"},
+		{variant: rules.INTERNATIONAL_VARIANT, fen: "W:W31-50:B1-20"},
+		{variant: rules.RUSSIAN_VARIANT, fen: "W:W21-32:B1-12"},
+		{variant: rules.ITALIAN_VARIANT, fen: "W:W21-32:B1-12"},
+		{variant: rules.POOL_VARIANT, fen: "B:W21-32:B1-12"},
+	} {
+		t.Run(tc.variant.Name, func(t *testing.T) {
+			start := tc.variant.New()
+			parsed, err := tc.variant.ParseFen(tc.fen)
+			require.Nil(t, err)
+			require.Equal(t, start, parsed)
+			again, err := tc.variant.ParseFen(start.Fen())
+			require.Nil(t, err)
+			require.Equal(t, start, again)
+		})
+	}
+}
+
+func TestFenRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		variant *rules.Variant
+		fen     string
+	}{
+		{variant: rules.AMERICAN_VARIANT, fen: "W:WK3,18:B12,K30"},
+		{variant: rules.AMERICAN_VARIANT, fen: "B:W32:B1"},
+		{variant: rules.INTERNATIONAL_VARIANT, fen: "W:W28,K46:BK5,23"},
+		{variant: rules.RUSSIAN_VARIANT, fen: "B:WK1,K3,6:B25,26"},
+	} {
+		t.Run(tc.fen, func(t *testing.T) {
+			game, err := tc.variant.ParseFen(tc.fen)
+			require.Nil(t, err)
+			require.Equal(t, tc.fen, game.Fen())
+		})
+	}
+}
+
+func TestFenPieces(t *testing.T) {
+	game, err := rules.ParseFen("W:WK3,18:B12,K30.")
+	require.Nil(t, err)
+	require.Equal(t, map[rules.Pos]rules.Piece{
+		{X: 5, Y: 0}: redKing,
+		{X: 3, Y: 4}: redMan,
+		{X: 7, Y: 2}: blackMan,
+		{X: 2, Y: 7}: blackKing,
+	}, game.Pieces)
+	require.True(t, game.TurnIs(rules.RED_PLAYER))
+	require.Equal(t, rules.AMERICAN_VARIANT, game.Variant)
+}
+
+func TestFenErrors(t *testing.T) {
+	for _, tc := range []struct {
+		fen string
+		err string
+	}{
+		{fen: "B:W21-32", err: "invalid FEN, expected side to move and two piece lists: B:W21-32"},
+		{fen: "X:W21-32:B1-12", err: "invalid FEN, unknown side to move: X"},
+		{fen: "B:W21-32:", err: "invalid FEN, empty piece list: B:W21-32:"},
+		{fen: "B:W21-32:X1-12", err: "invalid FEN, unknown side: X"},
+		{fen: "B:W21-32:Ba", err: "invalid FEN, not a square: a"},
+		{fen: "B:W21-32:B12-1", err: "invalid FEN, not a square: 12-1"},
+		{fen: "B:W21-33:B1-12", err: "Square out of board: 33"},
+		{fen: "B:W21,21:B1", err: "invalid FEN, square taken twice: 21"},
+		{fen: "B:W1:B12", err: "invalid FEN, man should have been crowned: 1"},
+		{fen: "B:W:B1-12", err: "invalid FEN, red has no piece"},
+		{fen: "B:W20-32:B1-12", err: "invalid FEN, red has more than 12 pieces"},
+	} {
+		t.Run(tc.fen, func(t *testing.T) {
+			_, err := rules.ParseFen(tc.fen)
+			require.EqualError(t, err, tc.err)
+		})
+	}
+}
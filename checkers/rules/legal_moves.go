@@ -0,0 +1,89 @@
+package rules
+
+import (
+	"sort"
+)
+
+// Move is a single step a piece can take. Captured is NO_POS unless the step is a jump.
+// When the same piece can capture again after a jump, the player keeps the turn and Then
+// lists the jumps it can follow up with.
+type Move struct {
+	Src      Pos
+	Dst      Pos
+	Captured Pos
+	Then     []Move
+}
+
+// LegalMoves lists every move the player can make, sorted by source then destination.
+// There are none when it is not the player's turn, and only jumps when a capture is available.
+func (game *Game) LegalMoves(player Player) []Move {
+	moves := []Move{}
+	if !game.TurnIs(player) {
+		return moves
+	}
+	for src, piece := range game.Pieces {
+		if piece.Player == player {
+			moves = append(moves, game.LegalMovesFrom(src)...)
+		}
+	}
+	sortMoves(moves)
+	return moves
+}
+
+// LegalMovesFrom lists the moves the piece at src can make, sorted by destination.
+func (game *Game) LegalMovesFrom(src Pos) []Move {
+	moves := []Move{}
+	if !game.PieceAt(src) || !game.TurnIs(game.Pieces[src].Player) {
+		return moves
+	}
+	for _, dst := range game.stepsFrom(src) {
+		if game.ValidMove(src, dst) {
+			moves = append(moves, Move{Src: src, Dst: dst, Captured: NO_POS})
+		}
+	}
+	for dst, captured := range game.jumpsFrom(src) {
+		if game.ValidMove(src, dst) {
+			moves = append(moves, Move{Src: src, Dst: dst, Captured: captured, Then: game.jumpsAfter(src, dst)})
+		}
+	}
+	sortMoves(moves)
+	return moves
+}
+
+// jumpsAfter plays the jump on a copy of the game and lists the jumps the piece can continue with.
+func (game *Game) jumpsAfter(src, dst Pos) []Move {
+	next := game.Clone()
+	if _, err := next.Move(src, dst); err != nil || !next.TurnIs(game.Turn) {
+		return nil
+	}
+	return next.LegalMovesFrom(dst)
+}
+
+// Clone copies the game, so that moves can be tried on the copy without changing the game.
+func (game *Game) Clone() *Game {
+	pieces := make(map[Pos]Piece, len(game.Pieces))
+	for pos, piece := range game.Pieces {
+		pieces[pos] = piece
+	}
+	history := make([]string, len(game.History))
+	copy(history, game.History)
+	clone := &Game{Pieces: pieces, Turn: game.Turn, History: history, MovesWithoutProgress: game.MovesWithoutProgress, Variant: game.Variant}
+	if game.Capturing != nil {
+		capturing := *game.Capturing
+		clone.Capturing = &capturing
+	}
+	if game.Captured != nil {
+		clone.Captured = append([]Pos{}, game.Captured...)
+	}
+	return clone
+}
+
+func sortMoves(moves []Move) {
+	sort.Slice(moves, func(i, j int) bool {
+		a, b := moves[i], moves[j]
+		if a.Src != b.Src {
+			return a.Src.Y < b.Src.Y || (a.Src.Y == b.Src.Y && a.Src.X < b.Src.X)
+		}
+		return a.Dst.Y < b.Dst.Y || (a.Dst.Y == b.Dst.Y && a.Dst.X < b.Dst.X)
+	})
+}
@@ -0,0 +1,289 @@
+package rules
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Results as they appear in PDN, seen from the player who moves first.
+const (
+	PDN_FIRST_WINS  = "1-0"
+	PDN_SECOND_WINS = "0-1"
+	PDN_DRAW        = "1/2-1/2"
+	PDN_ONGOING     = "*"
+)
+
+// PDN lines are kept under this width when rendering the moves.
+const PDN_LINE_WIDTH = 80
+
+// Black, at the top of the board, is given the lowest numbers and PDN calls red White.
+const (
+	PDN_BLACK     = "Black"
+	PDN_WHITE     = "White"
+	PDN_RESULT    = "Result"
+	PDN_GAME_TYPE = "GameType"
+	PDN_SET_UP    = "SetUp"
+	PDN_FEN       = "FEN"
+)
+
+type PdnHeader struct {
+	Name  string
+	Value string
+}
+
+// PdnMove is the path of the piece, with a single hop for a simple move and one hop per
+// capture for a jump. Flying kings go further than the next square on a simple move, so
+// the path alone does not tell whether pieces were captured.
+type PdnMove struct {
+	Path    []Pos
+	Capture bool
+}
+
+// Pdn is a game in Portable Draughts Notation.
+type Pdn struct {
+	Headers []PdnHeader
+	Variant *Variant
+	Moves   []PdnMove
+	Result  string
+}
+
+var pdnHeaderRegexp = regexp.MustCompile(`^\[(\w+)\s+("(?:[^"\\]|\\.)*")\]$`)
+var pdnMoveNumberRegexp = regexp.MustCompile(`^\d+\.+`)
+var pdnCommentRegexp = regexp.MustCompile(`\{[^}]*\}`)
+
+// Square returns the PDN number of a usable position. The squares are numbered row after row
+// from the top left, starting at 1.
+func (variant *Variant) Square(pos Pos) int {
+	return pos.Y*(variant.BoardDim/2) + pos.X/2 + 1
+}
+
+// SquarePos returns the position of a PDN square number.
+func (variant *Variant) SquarePos(square int) (pos Pos, err error) {
+	perRow := variant.BoardDim / 2
+	if square < 1 || perRow*variant.BoardDim < square {
+		return NO_POS, errors.New(fmt.Sprintf("Square out of board: %d", square))
+	}
+	y := (square - 1) / perRow
+	x := 2 * ((square - 1) % perRow)
+	if y%2 == 0 {
+		x += 1
+	}
+	return Pos{x, y}, nil
+}
+
+// PdnResult returns the PDN result of a game the winner of which is given.
+func (variant *Variant) PdnResult(winner Player) string {
+	switch winner {
+	case NO_PLAYER:
+		return PDN_ONGOING
+	case DRAW_PLAYER:
+		return PDN_DRAW
+	case variant.FirstPlayer:
+		return PDN_FIRST_WINS
+	default:
+		return PDN_SECOND_WINS
+	}
+}
+
+// FormatPdnMove renders a move as "11-15" for a simple move or "15x24x31" for captures.
+func (variant *Variant) FormatPdnMove(move PdnMove) string {
+	separator := "-"
+	if move.Capture {
+		separator = "x"
+	}
+	squares := make([]string, len(move.Path))
+	for i, pos := range move.Path {
+		squares[i] = strconv.Itoa(variant.Square(pos))
+	}
+	return strings.Join(squares, separator)
+}
+
+// ParsePdnMove reads a move written as "11-15" or "15x24x31".
+func (variant *Variant) ParsePdnMove(s string) (move PdnMove, err error) {
+	s = strings.TrimRight(s, "!?")
+	separator := "-"
+	if strings.Contains(s, "x") {
+		separator = "x"
+		move.Capture = true
+	}
+	squares := strings.Split(s, separator)
+	if len(squares) < 2 {
+		return PdnMove{}, errors.New(fmt.Sprintf("Not a move: %s", s))
+	}
+	for _, square := range squares {
+		number, err := strconv.Atoi(square)
+		if err != nil {
+			return PdnMove{}, errors.New(fmt.Sprintf("Not a move: %s", s))
+		}
+		pos, err := variant.SquarePos(number)
+		if err != nil {
+			return PdnMove{}, err
+		}
+		move.Path = append(move.Path, pos)
+	}
+	return move, nil
+}
+
+// Header returns the value of the named header.
+func (pdn *Pdn) Header(name string) (value string, found bool) {
+	for _, header := range pdn.Headers {
+		if header.Name == name {
+			return header.Value, true
+		}
+	}
+	return "", false
+}
+
+// String renders the headers, then the numbered moves and the result.
+func (pdn *Pdn) String() string {
+	var buf strings.Builder
+	for _, header := range pdn.Headers {
+		buf.WriteString(fmt.Sprintf("[%s %s]\n", header.Name, strconv.Quote(header.Value)))
+	}
+	buf.WriteString("\n")
+
+	// When the second player moves first from a set up position, the first move is numbered "1...".
+	var tokens []string
+	offset := 0
+	if start, err := pdn.start(); err == nil && start.Turn != pdn.Variant.FirstPlayer {
+		offset = 1
+		if 0 < len(pdn.Moves) {
+			tokens = append(tokens, "1...")
+		}
+	}
+	for i, move := range pdn.Moves {
+		if (i+offset)%2 == 0 {
+			tokens = append(tokens, fmt.Sprintf("%d.", (i+offset)/2+1))
+		}
+		tokens = append(tokens, pdn.Variant.FormatPdnMove(move))
+	}
+	tokens = append(tokens, pdn.Result)
+
+	lineLength := 0
+	for _, token := range tokens {
+		if 0 < lineLength && PDN_LINE_WIDTH < lineLength+1+len(token) {
+			buf.WriteString("\n")
+			lineLength = 0
+		} else if 0 < lineLength {
+			buf.WriteString(" ")
+			lineLength += 1
+		}
+		buf.WriteString(token)
+		lineLength += len(token)
+	}
+	buf.WriteString("\n")
+	return buf.String()
+}
+
+// ParsePdn reads a single game. The variant is taken from the GameType header, and is
+// American when there is none. Comments between braces are skipped.
+func ParsePdn(s string) (pdn *Pdn, err error) {
+	pdn = &Pdn{Variant: AMERICAN_VARIANT, Result: PDN_ONGOING}
+	var movetext strings.Builder
+	scanner := bufio.NewScanner(strings.NewReader(s))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "[") {
+			movetext.WriteString(line)
+			movetext.WriteString(" ")
+			continue
+		}
+		matches := pdnHeaderRegexp.FindStringSubmatch(line)
+		if matches == nil {
+			return nil, errors.New(fmt.Sprintf("Invalid header: %s", line))
+		}
+		value, err := strconv.Unquote(matches[2])
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("Invalid header: %s", line))
+		}
+		pdn.Headers = append(pdn.Headers, PdnHeader{Name: matches[1], Value: value})
+	}
+
+	if gameType, found := pdn.Header(PDN_GAME_TYPE); found {
+		// The game type may carry board details after a comma, only the number matters here.
+		pdn.Variant = nil
+		for _, variant := range Variants {
+			if strconv.Itoa(variant.PdnGameType) == strings.Split(gameType, ",")[0] {
+				pdn.Variant = variant
+			}
+		}
+		if pdn.Variant == nil {
+			return nil, errors.New(fmt.Sprintf("Unsupported game type: %s", gameType))
+		}
+	}
+
+	for _, token := range strings.Fields(pdnCommentRegexp.ReplaceAllString(movetext.String(), " ")) {
+		token = pdnMoveNumberRegexp.ReplaceAllString(token, "")
+		switch token {
+		case "":
+			continue
+		case PDN_FIRST_WINS, PDN_SECOND_WINS, PDN_DRAW, PDN_ONGOING:
+			pdn.Result = token
+			continue
+		}
+		move, err := pdn.Variant.ParsePdnMove(token)
+		if err != nil {
+			return nil, err
+		}
+		pdn.Moves = append(pdn.Moves, move)
+	}
+	return pdn, nil
+}
+
+// start returns the position given in the FEN header, or else the initial position.
+func (pdn *Pdn) start() (*Game, error) {
+	if fen, found := pdn.Header(PDN_FEN); found {
+		return pdn.Variant.ParseFen(fen)
+	}
+	return pdn.Variant.New(), nil
+}
+
+// Replay plays the moves from the FEN header position, or else from the initial position.
+// A capture written with only its first and last squares is expanded when a single sequence
+// of jumps links them.
+func (pdn *Pdn) Replay() (game *Game, err error) {
+	game, err = pdn.start()
+	if err != nil {
+		return nil, err
+	}
+	for i, move := range pdn.Moves {
+		path := move.Path
+		if move.Capture && len(path) == 2 {
+			if full := game.jumpPathTo(path[0], path[1]); full != nil {
+				path = full
+			}
+		}
+		if _, err := game.MoveAlong(path); err != nil {
+			return nil, errors.New(fmt.Sprintf("Move %d (%s): %s", i+1, pdn.Variant.FormatPdnMove(move), err.Error()))
+		}
+	}
+	return game, nil
+}
+
+// jumpPathTo returns the only sequence of jumps that takes the piece at src to dst,
+// or nil when there is none or several.
+func (game *Game) jumpPathTo(src, dst Pos) []Pos {
+	var found [][]Pos
+	var walk func(moves []Move, path []Pos)
+	walk = func(moves []Move, path []Pos) {
+		for _, move := range moves {
+			if move.Captured == NO_POS {
+				continue
+			}
+			next := append(append([]Pos{}, path...), move.Dst)
+			if move.Dst == dst && len(move.Then) == 0 {
+				found = append(found, next)
+			}
+			walk(move.Then, next)
+		}
+	}
+	walk(game.LegalMovesFrom(src), []Pos{src})
+	if len(found) != 1 {
+		return nil
+	}
+	return found[0]
+}
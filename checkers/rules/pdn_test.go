@@ -0,0 +1,137 @@
+package rules_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/alice/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+const shortGame = `[Black "alice"]
+[White "bob"]
+[Result "*"]
+[GameType "21"]
+
+1. 11-15 22-18 2. 15x22 25x18 *
+`
+
+func TestPdnRoundTrip(t *testing.T) {
+	pdn, err := rules.ParsePdn(shortGame)
+	require.Nil(t, err)
+	require.Equal(t, &rules.Pdn{
+		Headers: []rules.PdnHeader{
+			{Name: rules.PDN_BLACK, Value: "alice"},
+			{Name: rules.PDN_WHITE, Value: "bob"},
+			{Name: rules.PDN_RESULT, Value: rules.PDN_ONGOING},
+			{Name: rules.PDN_GAME_TYPE, Value: "21"},
+		},
+		Variant: rules.AMERICAN_VARIANT,
+		Moves: []rules.PdnMove{
+			{Path: []rules.Pos{{X: 5, Y: 2}, {X: 4, Y: 3}}},
+			{Path: []rules.Pos{{X: 2, Y: 5}, {X: 3, Y: 4}}},
+			{Path: []rules.Pos{{X: 4, Y: 3}, {X: 2, Y: 5}}, Capture: true},
+			{Path: []rules.Pos{{X: 1, Y: 6}, {X: 3, Y: 4}}, Capture: true},
+		},
+		Result: rules.PDN_ONGOING,
+	}, pdn)
+	require.Equal(t, shortGame, pdn.String())
+
+	again, err := rules.ParsePdn(pdn.String())
+	require.Nil(t, err)
+	require.Equal(t, pdn, again)
+}
+
+func TestPdnReplay(t *testing.T) {
+	pdn, err := rules.ParsePdn(shortGame)
+	require.Nil(t, err)
+	game, err := pdn.Replay()
+	require.Nil(t, err)
+	require.Equal(t, "B:W18,21,23,24,26,27,28,29,30,31,32:B1,2,3,4,5,6,7,8,9,10,12", game.Fen())
+}
+
+func TestPdnReplayExpandsCapture(t *testing.T) {
+	pdn, err := rules.ParsePdn("[FEN \"W:W25:B14,22\"]\n\n1... 25x9 1-0\n")
+	require.Nil(t, err)
+	require.Equal(t, rules.PDN_FIRST_WINS, pdn.Result)
+	game, err := pdn.Replay()
+	require.Nil(t, err)
+	require.Equal(t, "B:W9:B", game.Fen())
+	require.Equal(t, rules.RED_PLAYER, game.Status())
+}
+
+func TestPdnSetUpStartsWithSecondPlayer(t *testing.T) {
+	pdn := &rules.Pdn{
+		Headers: []rules.PdnHeader{{Name: rules.PDN_FEN, Value: "W:W25:B14,22"}},
+		Variant: rules.AMERICAN_VARIANT,
+		Moves: []rules.PdnMove{
+			{Path: []rules.Pos{{X: 1, Y: 6}, {X: 3, Y: 4}, {X: 1, Y: 2}}, Capture: true},
+		},
+		Result: rules.PDN_SECOND_WINS,
+	}
+	require.Equal(t, "[FEN \"W:W25:B14,22\"]\n\n1... 25x18x9 0-1\n", pdn.String())
+}
+
+func TestPdnVariants(t *testing.T) {
+	for _, variant := range []*rules.Variant{
+		rules.AMERICAN_VARIANT,
+		rules.INTERNATIONAL_VARIANT,
+		rules.RUSSIAN_VARIANT,
+		rules.ITALIAN_VARIANT,
+		rules.POOL_VARIANT,
+	} {
+		t.Run(variant.Name, func(t *testing.T) {
+			pdn := &rules.Pdn{
+				Headers: []rules.PdnHeader{{Name: rules.PDN_GAME_TYPE, Value: strconv.Itoa(variant.PdnGameType)}},
+				Variant: variant,
+				Result:  rules.PDN_ONGOING,
+			}
+			parsed, err := rules.ParsePdn(pdn.String())
+			require.Nil(t, err)
+			require.Equal(t, variant, parsed.Variant)
+			game, err := parsed.Replay()
+			require.Nil(t, err)
+			require.Equal(t, variant.New(), game)
+		})
+	}
+}
+
+func TestPdnResults(t *testing.T) {
+	for _, tc := range []struct {
+		variant *rules.Variant
+		winner  rules.Player
+		result  string
+	}{
+		{variant: rules.AMERICAN_VARIANT, winner: rules.NO_PLAYER, result: rules.PDN_ONGOING},
+		{variant: rules.AMERICAN_VARIANT, winner: rules.DRAW_PLAYER, result: rules.PDN_DRAW},
+		{variant: rules.AMERICAN_VARIANT, winner: rules.BLACK_PLAYER, result: rules.PDN_FIRST_WINS},
+		{variant: rules.AMERICAN_VARIANT, winner: rules.RED_PLAYER, result: rules.PDN_SECOND_WINS},
+		{variant: rules.INTERNATIONAL_VARIANT, winner: rules.RED_PLAYER, result: rules.PDN_FIRST_WINS},
+		{variant: rules.INTERNATIONAL_VARIANT, winner: rules.BLACK_PLAYER, result: rules.PDN_SECOND_WINS},
+	} {
+		require.Equal(t, tc.result, tc.variant.PdnResult(tc.winner))
+	}
+}
+
+func TestPdnErrors(t *testing.T) {
+	for _, tc := range []struct {
+		pdn string
+		err string
+	}{
+		{pdn: "[Black alice]\n\n*\n", err: "Invalid header: [Black alice]"},
+		{pdn: "[GameType \"99\"]\n\n*\n", err: "Unsupported game type: 99"},
+		{pdn: "1. 11-40 *\n", err: "Square out of board: 40"},
+		{pdn: "1. 11 *\n", err: "Not a move: 11"},
+		{pdn: "1. 11-a *\n", err: "Not a move: 11-a"},
+	} {
+		t.Run(tc.err, func(t *testing.T) {
+			_, err := rules.ParsePdn(tc.pdn)
+			require.EqualError(t, err, tc.err)
+		})
+	}
+
+	pdn, err := rules.ParsePdn("1. 11-15 22-17 2. 15-11 *\n")
+	require.Nil(t, err)
+	_, err = pdn.Replay()
+	require.EqualError(t, err, "Move 3 (15-11): Invalid move: {4 3} to {5 2}")
+}
@@ -0,0 +1,113 @@
+package rules
+
+const (
+	AMERICAN      = "american"
+	INTERNATIONAL = "international"
+	RUSSIAN       = "russian"
+	ITALIAN       = "italian"
+	POOL          = "pool"
+)
+
+// Variant describes the board a game is played on and the rules that differ between
+// the checkers played around the world.
+type Variant struct {
+	Name     string
+	BoardDim int
+	// Kings move and capture along a whole diagonal instead of a single square.
+	FlyingKings bool
+	// Men capture backwards too, although they still only move forwards.
+	MenCaptureBackwards bool
+	// A player has to take the sequence that captures the most pieces.
+	MajorityCapture bool
+	// A man reaching the far row while capturing is crowned at once and goes on capturing as a king.
+	// Otherwise it is only crowned when its turn ends there.
+	CrownMidCapture bool
+	// Only kings can capture kings.
+	MenCannotCaptureKings bool
+	FirstPlayer           Player
+	// The GameType header value that identifies the variant in PDN files.
+	PdnGameType int
+}
+
+// In all variants, black starts on the rows at the top of the board and red on those at
+// the bottom. Where the rules have white play first, red stands in for white.
+var AMERICAN_VARIANT = &Variant{
+	Name:        AMERICAN,
+	BoardDim:    BOARD_DIM,
+	FirstPlayer: BLACK_PLAYER,
+	PdnGameType: 21,
+}
+
+var INTERNATIONAL_VARIANT = &Variant{
+	Name:                INTERNATIONAL,
+	BoardDim:            10,
+	FlyingKings:         true,
+	MenCaptureBackwards: true,
+	MajorityCapture:     true,
+	FirstPlayer:         RED_PLAYER,
+	PdnGameType:         20,
+}
+
+var RUSSIAN_VARIANT = &Variant{
+	Name:                RUSSIAN,
+	BoardDim:            BOARD_DIM,
+	FlyingKings:         true,
+	MenCaptureBackwards: true,
+	CrownMidCapture:     true,
+	FirstPlayer:         RED_PLAYER,
+	PdnGameType:         25,
+}
+
+var ITALIAN_VARIANT = &Variant{
+	Name:                  ITALIAN,
+	BoardDim:              BOARD_DIM,
+	MajorityCapture:       true,
+	MenCannotCaptureKings: true,
+	FirstPlayer:           RED_PLAYER,
+	PdnGameType:           22,
+}
+
+var POOL_VARIANT = &Variant{
+	Name:                POOL,
+	BoardDim:            BOARD_DIM,
+	FlyingKings:         true,
+	MenCaptureBackwards: true,
+	FirstPlayer:         BLACK_PLAYER,
+	PdnGameType:         23,
+}
+
+// Variants by name. Games that do not name a variant are played with American rules.
+var Variants = map[string]*Variant{
+	"":            AMERICAN_VARIANT,
+	AMERICAN:      AMERICAN_VARIANT,
+	INTERNATIONAL: INTERNATIONAL_VARIANT,
+	RUSSIAN:       RUSSIAN_VARIANT,
+	ITALIAN:       ITALIAN_VARIANT,
+	POOL:          POOL_VARIANT,
+}
+
+func (variant *Variant) IsUsable(pos Pos) bool {
+	return 0 <= pos.X && pos.X < variant.BoardDim &&
+		0 <= pos.Y && pos.Y < variant.BoardDim &&
+		(pos.X+pos.Y)%2 == 1
+}
+
+// The number of rows each player fills at the start, leaving two empty rows in the middle.
+func (variant *Variant) startingRows() int {
+	return (variant.BoardDim - 2) / 2
+}
+
+// The row where the player's men are crowned, on the far side of the board.
+func (variant *Variant) kingRow(player Player) int {
+	if player == BLACK_PLAYER {
+		return variant.BoardDim - 1
+	}
+	return 0
+}
+
+func (variant *Variant) New() *Game {
+	pieces := make(map[Pos]Piece)
+	game := &Game{Pieces: pieces, Turn: variant.FirstPlayer, Variant: variant}
+	game.addInitialPieces()
+	return game
+}
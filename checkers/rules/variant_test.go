@@ -0,0 +1,189 @@
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/rules"
+	"github.com/stretchr/testify/require"
+)
+
+// A red man captures onto the far row, then can capture again backwards.
+func captureThroughKingRow(variant *rules.Variant) *rules.Game {
+	return newGame(variant, rules.RED_PLAYER, map[rules.Pos]rules.Piece{
+		{X: 5, Y: 2}: redMan,
+		{X: 4, Y: 1}: blackMan,
+		{X: 2, Y: 1}: blackMan,
+	})
+}
+
+func TestCrowningMidCapture(t *testing.T) {
+	for _, tc := range []struct {
+		variant *rules.Variant
+		king    bool
+		then    []rules.Pos
+	}{
+		{variant: rules.INTERNATIONAL_VARIANT, king: false, then: []rules.Pos{{X: 1, Y: 2}}},
+		{variant: rules.POOL_VARIANT, king: false, then: []rules.Pos{{X: 1, Y: 2}}},
+		{variant: rules.RUSSIAN_VARIANT, king: true, then: []rules.Pos{{X: 1, Y: 2}, {X: 0, Y: 3}}},
+	} {
+		t.Run(tc.variant.Name, func(t *testing.T) {
+			game := captureThroughKingRow(tc.variant)
+			captured, err := game.Move(rules.Pos{X: 5, Y: 2}, rules.Pos{X: 3, Y: 0})
+			require.Nil(t, err)
+			require.Equal(t, rules.Pos{X: 4, Y: 1}, captured)
+			require.True(t, game.TurnIs(rules.RED_PLAYER))
+			require.Equal(t, tc.king, game.Pieces[rules.Pos{X: 3, Y: 0}].King)
+			then := []rules.Pos{}
+			for _, move := range game.LegalMoves(rules.RED_PLAYER) {
+				require.Equal(t, rules.Pos{X: 3, Y: 0}, move.Src)
+				then = append(then, move.Dst)
+			}
+			require.Equal(t, tc.then, then)
+		})
+	}
+}
+
+func TestNoCrowningWhenPassingThroughKingRow(t *testing.T) {
+	game := captureThroughKingRow(rules.INTERNATIONAL_VARIANT)
+	_, err := game.MoveAlong([]rules.Pos{{X: 5, Y: 2}, {X: 3, Y: 0}, {X: 1, Y: 2}})
+	require.Nil(t, err)
+	require.True(t, game.TurnIs(rules.BLACK_PLAYER))
+	require.Equal(t, redMan, game.Pieces[rules.Pos{X: 1, Y: 2}])
+}
+
+func TestCrowningAtEndOfCapture(t *testing.T) {
+	for _, variant := range []*rules.Variant{rules.AMERICAN_VARIANT, rules.INTERNATIONAL_VARIANT, rules.RUSSIAN_VARIANT} {
+		t.Run(variant.Name, func(t *testing.T) {
+			game := newGame(variant, rules.RED_PLAYER, map[rules.Pos]rules.Piece{
+				{X: 3, Y: 2}: redMan,
+				{X: 2, Y: 1}: blackMan,
+				{X: 7, Y: 4}: blackMan,
+			})
+			_, err := game.Move(rules.Pos{X: 3, Y: 2}, rules.Pos{X: 1, Y: 0})
+			require.Nil(t, err)
+			require.True(t, game.TurnIs(rules.BLACK_PLAYER))
+			require.Equal(t, redKing, game.Pieces[rules.Pos{X: 1, Y: 0}])
+		})
+	}
+}
+
+func TestMenCannotCaptureKings(t *testing.T) {
+	pieces := func() map[rules.Pos]rules.Piece {
+		return map[rules.Pos]rules.Piece{
+			{X: 2, Y: 5}: redMan,
+			{X: 3, Y: 4}: blackKing,
+		}
+	}
+	italian := newGame(rules.ITALIAN_VARIANT, rules.RED_PLAYER, pieces())
+	require.Equal(t, []rules.Move{
+		{Src: rules.Pos{X: 2, Y: 5}, Dst: rules.Pos{X: 1, Y: 4}, Captured: rules.NO_POS},
+	}, italian.LegalMoves(rules.RED_PLAYER))
+
+	american := newGame(rules.AMERICAN_VARIANT, rules.RED_PLAYER, pieces())
+	require.Equal(t, []rules.Move{
+		{Src: rules.Pos{X: 2, Y: 5}, Dst: rules.Pos{X: 4, Y: 3}, Captured: rules.Pos{X: 3, Y: 4}},
+	}, american.LegalMoves(rules.RED_PLAYER))
+
+	kings := pieces()
+	kings[rules.Pos{X: 2, Y: 5}] = redKing
+	italian = newGame(rules.ITALIAN_VARIANT, rules.RED_PLAYER, kings)
+	_, err := italian.Move(rules.Pos{X: 2, Y: 5}, rules.Pos{X: 4, Y: 3})
+	require.Nil(t, err)
+	require.False(t, italian.PieceAt(rules.Pos{X: 3, Y: 4}))
+}
+
+// Two black men can each capture two pieces, the one on {1 0} through {3 2}.
+func twoLongestCaptures() *rules.Game {
+	return newGame(rules.INTERNATIONAL_VARIANT, rules.BLACK_PLAYER, map[rules.Pos]rules.Piece{
+		{X: 1, Y: 0}: blackMan,
+		{X: 9, Y: 0}: blackMan,
+		{X: 2, Y: 1}: redMan,
+		{X: 4, Y: 3}: redMan,
+		{X: 8, Y: 1}: redMan,
+		{X: 8, Y: 3}: redMan,
+	})
+}
+
+func TestMajorityCaptureFinishedByCapturingPiece(t *testing.T) {
+	game := twoLongestCaptures()
+	moves := game.LegalMovesFrom(rules.Pos{X: 1, Y: 0})
+	require.Equal(t, []rules.Move{
+		{
+			Src:      rules.Pos{X: 1, Y: 0},
+			Dst:      rules.Pos{X: 3, Y: 2},
+			Captured: rules.Pos{X: 2, Y: 1},
+			Then: []rules.Move{
+				{Src: rules.Pos{X: 3, Y: 2}, Dst: rules.Pos{X: 5, Y: 4}, Captured: rules.Pos{X: 4, Y: 3}},
+			},
+		},
+	}, moves)
+
+	captured, err := game.MoveAlong([]rules.Pos{{X: 1, Y: 0}, {X: 3, Y: 2}, {X: 5, Y: 4}})
+	require.Nil(t, err)
+	require.Equal(t, []rules.Pos{{X: 2, Y: 1}, {X: 4, Y: 3}}, captured)
+	require.True(t, game.TurnIs(rules.RED_PLAYER))
+	require.Nil(t, game.Capturing)
+}
+
+func TestMultiJumpLocksTurnToCapturingPiece(t *testing.T) {
+	game := twoLongestCaptures()
+	_, err := game.Move(rules.Pos{X: 1, Y: 0}, rules.Pos{X: 3, Y: 2})
+	require.Nil(t, err)
+	require.True(t, game.TurnIs(rules.BLACK_PLAYER))
+	require.Equal(t, &rules.Pos{X: 3, Y: 2}, game.Capturing)
+	require.Equal(t, []rules.Move{
+		{Src: rules.Pos{X: 3, Y: 2}, Dst: rules.Pos{X: 5, Y: 4}, Captured: rules.Pos{X: 4, Y: 3}},
+	}, game.LegalMoves(rules.BLACK_PLAYER))
+
+	_, err = game.Move(rules.Pos{X: 9, Y: 0}, rules.Pos{X: 7, Y: 2})
+	require.EqualError(t, err, "Invalid move: {9 0} to {7 2}")
+	_, err = game.Move(rules.Pos{X: 3, Y: 2}, rules.Pos{X: 5, Y: 4})
+	require.Nil(t, err)
+	require.True(t, game.TurnIs(rules.RED_PLAYER))
+	require.Nil(t, game.Capturing)
+}
+
+// Turkish strike: the pieces a king captured stay on the board until the multi-jump is over.
+func TestCapturedPiecesCannotBeCrossedAgain(t *testing.T) {
+	game := newGame(rules.RUSSIAN_VARIANT, rules.RED_PLAYER, map[rules.Pos]rules.Piece{
+		{X: 0, Y: 3}: redKing,
+		{X: 1, Y: 4}: blackMan,
+		{X: 3, Y: 4}: blackMan,
+		{X: 3, Y: 2}: blackMan,
+		{X: 1, Y: 2}: blackMan,
+		{X: 3, Y: 6}: blackMan,
+	})
+	_, err := game.Clone().MoveAlong([]rules.Pos{{X: 0, Y: 3}, {X: 2, Y: 5}, {X: 4, Y: 3}, {X: 2, Y: 1}, {X: 0, Y: 3}, {X: 4, Y: 7}})
+	require.NotNil(t, err)
+
+	captured, err := game.MoveAlong([]rules.Pos{{X: 0, Y: 3}, {X: 2, Y: 5}, {X: 4, Y: 3}, {X: 2, Y: 1}, {X: 0, Y: 3}})
+	require.Nil(t, err)
+	require.Equal(t, []rules.Pos{{X: 1, Y: 4}, {X: 3, Y: 4}, {X: 3, Y: 2}, {X: 1, Y: 2}}, captured)
+	require.True(t, game.TurnIs(rules.BLACK_PLAYER))
+	require.Nil(t, game.Capturing)
+	require.Nil(t, game.Captured)
+	require.Equal(t, map[rules.Pos]rules.Piece{
+		{X: 0, Y: 3}: redKing,
+		{X: 3, Y: 6}: blackMan,
+	}, game.Pieces)
+}
+
+func TestMajorityCaptureDoesNotCountCapturedPiecesTwice(t *testing.T) {
+	// Capturing {4 3} then flying back over it to capture {2 1} would count two.
+	game := newGame(rules.INTERNATIONAL_VARIANT, rules.RED_PLAYER, map[rules.Pos]rules.Piece{
+		{X: 3, Y: 2}: redKing,
+		{X: 4, Y: 3}: blackMan,
+		{X: 2, Y: 1}: blackMan,
+		{X: 7, Y: 2}: redMan,
+		{X: 6, Y: 1}: blackMan,
+	})
+	require.True(t, game.ValidMove(rules.Pos{X: 7, Y: 2}, rules.Pos{X: 5, Y: 0}))
+	for _, move := range game.LegalMoves(rules.RED_PLAYER) {
+		require.Empty(t, move.Then)
+	}
+
+	_, err := game.Move(rules.Pos{X: 3, Y: 2}, rules.Pos{X: 5, Y: 4})
+	require.Nil(t, err)
+	require.True(t, game.TurnIs(rules.BLACK_PLAYER))
+	require.True(t, game.PieceAt(rules.Pos{X: 2, Y: 1}))
+}
@@ -7,8 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// test to see that the balanaces dont change if nobody has played and the game has expired.
-func (suite *IntegrationTestSuite) TestForfeitUnplayedNotPaid() {
+// Nobody has played, so both players get back the wagers they escrowed.
+func (suite *IntegrationTestSuite) TestForfeitUnplayedRefunded() {
 	suite.setupSuiteWithOneGameForPlayMove()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	keeper := suite.app.CheckersKeeper
@@ -18,9 +18,9 @@ func (suite *IntegrationTestSuite) TestForfeitUnplayedNotPaid() {
 
 	keeper.SetStoredGame(suite.ctx, game1)
 	suite.RequireBankBalance(balAlice, alice)
-	suite.RequireBankBalance(balBob, bob)
-	suite.RequireBankBalance(balCarol, carol)
-	suite.RequireBankBalance(0, checkersModuleAddress)
+	suite.RequireBankBalance(balBob-45, bob)
+	suite.RequireBankBalance(balCarol-45, carol)
+	suite.RequireBankBalance(90, checkersModuleAddress)
 
 	keeper.ForfeitExpiredGames(goCtx)
 
@@ -30,7 +30,7 @@ func (suite *IntegrationTestSuite) TestForfeitUnplayedNotPaid() {
 	suite.RequireBankBalance(0, checkersModuleAddress)
 }
 
-// This tests that once someone has played and the other person forfeits the money gets retuned to the person who won / played first.
+// Only one move was played, so the game is not lost and both wagers are refunded.
 
 func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefunded() {
 	suite.setupSuiteWithOneGameForPlayMove()
@@ -51,8 +51,8 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefunded() {
 
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob-45, bob)
-	suite.RequireBankBalance(balCarol, carol)
-	suite.RequireBankBalance(45, checkersModuleAddress)
+	suite.RequireBankBalance(balCarol-45, carol)
+	suite.RequireBankBalance(90, checkersModuleAddress)
 
 	keeper.ForfeitExpiredGames(goCtx)
 
@@ -83,9 +83,9 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmitted() {
 	keeper.ForfeitExpiredGames(goCtx)
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 8)
 
-	forfeitEvents := events[2]
+	forfeitEvents := events[3]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -95,23 +95,30 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmitted() {
 		},
 	}, forfeitEvents)
 
-	transferEvent := events[6]
+	transferEvent := events[7]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: bob},
 		{Key: "sender", Value: checkersModuleAddress},
 		{Key: "amount", Value: "45stake"},
-	}, transferEvent.Attributes[3:])
+		{Key: "recipient", Value: carol},
+		{Key: "sender", Value: checkersModuleAddress},
+		{Key: "amount", Value: "45stake"},
+	}, transferEvent.Attributes[6:])
 }
 
 func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
-		Creator: bob,
+		Creator: carol,
 		Black:   carol,
 		Red:     alice,
-		Wager:   0,
+		Wager:   sdk.NewInt64Coin("stake", 0),
+	})
+	suite.msgServer.AcceptGame(goCtx, &types.MsgAcceptGame{
+		Creator:   alice,
+		GameIndex: "1",
 	})
 	suite.RequireBankBalance(balCarol, carol)
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
@@ -144,10 +151,14 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmittedEvenZero(
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
-		Creator: bob,
+		Creator: carol,
 		Black:   carol,
 		Red:     alice,
-		Wager:   0,
+		Wager:   sdk.NewInt64Coin("stake", 0),
+	})
+	suite.msgServer.AcceptGame(goCtx, &types.MsgAcceptGame{
+		Creator:   alice,
+		GameIndex: "1",
 	})
 	suite.RequireBankBalance(balCarol, carol)
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
@@ -165,9 +176,9 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmittedEvenZero(
 
 	keeper.ForfeitExpiredGames(goCtx)
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 8)
 
-	forfeitEvent := events[2]
+	forfeitEvent := events[3]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -177,13 +188,16 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedOnceRefundedEmittedEvenZero(
 		},
 	}, forfeitEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[7]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: carol},
 		{Key: "sender", Value: checkersModuleAddress},
 		{Key: "amount", Value: ""},
-	}, transferEvent.Attributes[3:])
+		{Key: "recipient", Value: alice},
+		{Key: "sender", Value: checkersModuleAddress},
+		{Key: "amount", Value: ""},
+	}, transferEvent.Attributes[6:])
 }
 
 func (suite *IntegrationTestSuite) TestForfeitPlayedTwicePaid() {
@@ -255,9 +269,9 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedTwicePaidEmitted() {
 	keeper.ForfeitExpiredGames(goCtx)
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 8)
 
-	forfeitEvent := events[2]
+	forfeitEvent := events[3]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -267,7 +281,7 @@ func (suite *IntegrationTestSuite) TestForfeitPlayedTwicePaidEmitted() {
 		},
 	}, forfeitEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[7]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: carol},
@@ -280,10 +294,14 @@ func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: carol,
 		Red:     bob,
 		Black:   carol,
-		Wager:   0,
+		Wager:   sdk.NewInt64Coin("stake", 0),
+	})
+	suite.msgServer.AcceptGame(goCtx, &types.MsgAcceptGame{
+		Creator:   bob,
+		GameIndex: "1",
 	})
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
 		Creator:   carol,
@@ -324,10 +342,14 @@ func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEmittedEvenZer
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: carol,
 		Red:     bob,
 		Black:   carol,
-		Wager:   0,
+		Wager:   sdk.NewInt64Coin("stake", 0),
+	})
+	suite.msgServer.AcceptGame(goCtx, &types.MsgAcceptGame{
+		Creator:   bob,
+		GameIndex: "1",
 	})
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
 		Creator:   carol,
@@ -353,9 +375,9 @@ func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEmittedEvenZer
 	keeper.ForfeitExpiredGames(goCtx)
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 8)
 
-	forfeitEvent := events[2]
+	forfeitEvent := events[3]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -365,7 +387,7 @@ func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEmittedEvenZer
 		},
 	}, forfeitEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[7]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: bob},
@@ -373,3 +395,30 @@ func (suite *IntegrationTestSuite) TestForfeitOlderPlayedTwicePaidEmittedEvenZer
 		{Key: "amount", Value: ""},
 	}, transferEvent.Attributes[6:])
 }
+
+func (suite *IntegrationTestSuite) TestForfeitUnacceptedRefunded() {
+	suite.setupSuiteWithBalances()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+	})
+	keeper := suite.app.CheckersKeeper
+	game1, found := keeper.GetStoredGame(suite.ctx, "1")
+	suite.Require().True(found)
+	game1.Deadline = types.FormatDeadline(suite.ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.SetStoredGame(suite.ctx, game1)
+
+	suite.RequireBankBalance(balBob-45, bob)
+	suite.RequireBankBalance(balCarol, carol)
+	suite.RequireBankBalance(45, checkersModuleAddress)
+
+	keeper.ForfeitExpiredGames(goCtx)
+
+	suite.RequireBankBalance(balAlice, alice)
+	suite.RequireBankBalance(balBob, bob)
+	suite.RequireBankBalance(balCarol, carol)
+	suite.RequireBankBalance(0, checkersModuleAddress)
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *IntegrationTestSuite) setupSuiteWithOneGameForDraw() {
+	suite.setupSuiteWithBalances()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+	})
+	suite.msgServer.AcceptGame(goCtx, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+}
+
+func (suite *IntegrationTestSuite) TestAcceptDrawNoMoveRefunded() {
+	suite.setupSuiteWithOneGameForDraw()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	suite.msgServer.OfferDraw(goCtx, &types.MsgOfferDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	_, err := suite.msgServer.AcceptDraw(goCtx, &types.MsgAcceptDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	suite.Require().Nil(err)
+	suite.RequireBankBalance(balAlice, alice)
+	suite.RequireBankBalance(balBob, bob)
+	suite.RequireBankBalance(balCarol, carol)
+	suite.RequireBankBalance(0, checkersModuleAddress)
+}
+
+func (suite *IntegrationTestSuite) TestAcceptDrawTwoMovesRefunded() {
+	suite.setupSuiteWithOneGameForDraw()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	suite.RequireBankBalance(balBob-45, bob)
+	suite.RequireBankBalance(balCarol-45, carol)
+	suite.RequireBankBalance(90, checkersModuleAddress)
+	suite.msgServer.OfferDraw(goCtx, &types.MsgOfferDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	_, err := suite.msgServer.AcceptDraw(goCtx, &types.MsgAcceptDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	suite.Require().Nil(err)
+	suite.RequireBankBalance(balAlice, alice)
+	suite.RequireBankBalance(balBob, bob)
+	suite.RequireBankBalance(balCarol, carol)
+	suite.RequireBankBalance(0, checkersModuleAddress)
+}
@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *IntegrationTestSuite) TestAcceptGameBothPaid() {
+	suite.setupSuiteWithBalances()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+	})
+	suite.RequireBankBalance(balBob-45, bob)
+	suite.RequireBankBalance(balCarol, carol)
+	suite.RequireBankBalance(45, checkersModuleAddress)
+	_, err := suite.msgServer.AcceptGame(goCtx, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	suite.Require().Nil(err)
+	suite.RequireBankBalance(balAlice, alice)
+	suite.RequireBankBalance(balBob-45, bob)
+	suite.RequireBankBalance(balCarol-45, carol)
+	suite.RequireBankBalance(90, checkersModuleAddress)
+}
+
+func (suite *IntegrationTestSuite) TestAcceptGameCannotPayFails() {
+	suite.setupSuiteWithBalances()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewCoin("stake", sdk.NewIntFromUint64(balCarol+1)),
+	})
+	acceptGameResponse, err := suite.msgServer.AcceptGame(goCtx, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	suite.Require().Nil(acceptGameResponse)
+	suite.Require().Equal("red cannot pay the wager: 10000000stake is smaller than 10000001stake: insufficient funds", err.Error())
+	game1, found := suite.app.CheckersKeeper.GetStoredGame(suite.ctx, "1")
+	suite.Require().True(found)
+	suite.Require().Equal(types.GameStatusPending, game1.Status)
+}
@@ -9,18 +9,16 @@ func (suite *IntegrationTestSuite) TestCreate1GameHasSaved() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	keeper := suite.app.CheckersKeeper
 	systemInfo, found := keeper.GetSystemInfo(suite.ctx)
 	suite.Require().True(found)
 	suite.Require().EqualValues(types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId: 2,
 	}, systemInfo)
 	game1, found1 := keeper.GetStoredGame(suite.ctx, "1")
 	suite.Require().True(found1)
@@ -31,15 +29,17 @@ func (suite *IntegrationTestSuite) TestCreate1GameHasSaved() {
 		Black:       bob,
 		Red:         carol,
 		MoveCount:   uint64(0),
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(suite.ctx.BlockTime().Add(types.MaxTurnDuration)),
+		Deadline:    types.FormatDeadline(suite.ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl: types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
 		Winner:      "*",
 		Wager:       45,
+		Denom:       "stake",
+		Status:      types.GameStatusPending,
+		Creator:     bob,
 	}, game1)
 }
 
-func (suite *IntegrationTestSuite) TestCreateGameDidNotPay() {
+func (suite *IntegrationTestSuite) TestCreateGameCreatorPaid() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.RequireBankBalance(balAlice, alice)
@@ -47,13 +47,28 @@ func (suite *IntegrationTestSuite) TestCreateGameDidNotPay() {
 	suite.RequireBankBalance(balCarol, carol)
 	suite.RequireBankBalance(0, checkersModuleAddress)
 	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	suite.RequireBankBalance(balAlice, alice)
-	suite.RequireBankBalance(balBob, bob)
+	suite.RequireBankBalance(balBob-45, bob)
+	suite.RequireBankBalance(balCarol, carol)
+	suite.RequireBankBalance(45, checkersModuleAddress)
+}
+
+func (suite *IntegrationTestSuite) TestCreateGameCannotPayFails() {
+	suite.setupSuiteWithBalances()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	createGameResponse, err := suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
+		Creator: carol,
+		Black:   carol,
+		Red:     alice,
+		Wager:   sdk.NewCoin("stake", sdk.NewIntFromUint64(balCarol+1)),
+	})
+	suite.Require().Nil(createGameResponse)
+	suite.Require().Equal("black cannot pay the wager: 10000000stake is smaller than 10000001stake: insufficient funds", err.Error())
 	suite.RequireBankBalance(balCarol, carol)
 	suite.RequireBankBalance(0, checkersModuleAddress)
 }
@@ -10,10 +10,14 @@ func (suite *IntegrationTestSuite) setupSuiteWithOneGameForPlayMove() {
 	suite.setupSuiteWithBalances() // sets up the initial genesis state for the bank module with balances for bob, alice and carol.
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+	})
+	suite.msgServer.AcceptGame(goCtx, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
 	})
 }
 
@@ -33,34 +37,36 @@ func (suite *IntegrationTestSuite) TestPlayMoveSavedGame() {
 	systemInfo, found := keeper.GetSystemInfo(suite.ctx)
 	suite.Require().True(found)
 	suite.Require().EqualValues(types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId: 2,
 	}, systemInfo)
 	game1, found := keeper.GetStoredGame(suite.ctx, "1")
 	suite.Require().True(found)
 	suite.Require().EqualValues(types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "r",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   uint64(1),
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(suite.ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-		Wager:       45,
+		Index:           "1",
+		Board:           "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:            "r",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       uint64(1),
+		Deadline:        types.FormatDeadline(suite.ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl:     types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
+		Winner:          "*",
+		Wager:           45,
+		PositionHistory: []string{"*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*|r"},
+		Denom:           "stake",
+		Status:          types.GameStatusActive,
+		Creator:         bob,
 	}, game1)
 }
 
-func (suite *IntegrationTestSuite) TestPlayMovePlayerPaid() {
+// Both wagers were escrowed when the game was created and accepted, moves do not pay.
+func (suite *IntegrationTestSuite) TestPlayMoveDidNotPay() {
 	suite.setupSuiteWithOneGameForPlayMove()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.RequireBankBalance(balAlice, alice)
-	suite.RequireBankBalance(balBob, bob)
-	suite.RequireBankBalance(balCarol, carol)
-	suite.RequireBankBalance(0, checkersModuleAddress)
+	suite.RequireBankBalance(balBob-45, bob)
+	suite.RequireBankBalance(balCarol-45, carol)
+	suite.RequireBankBalance(90, checkersModuleAddress)
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -71,18 +77,22 @@ func (suite *IntegrationTestSuite) TestPlayMovePlayerPaid() {
 	})
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob-45, bob)
-	suite.RequireBankBalance(balCarol, carol)
-	suite.RequireBankBalance(45, checkersModuleAddress)
+	suite.RequireBankBalance(balCarol-45, carol)
+	suite.RequireBankBalance(90, checkersModuleAddress)
 }
 
-func (suite *IntegrationTestSuite) TestPlayMovePlayerPaidEvenZero() {
+func (suite *IntegrationTestSuite) TestPlayMoveDidNotPayEvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
-		Creator: bob,
+		Creator: carol,
 		Black:   carol,
 		Red:     alice,
-		Wager:   0,
+		Wager:   sdk.NewInt64Coin("stake", 0),
+	})
+	suite.msgServer.AcceptGame(goCtx, &types.MsgAcceptGame{
+		Creator:   alice,
+		GameIndex: "1",
 	})
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob, bob)
@@ -96,37 +106,10 @@ func (suite *IntegrationTestSuite) TestPlayMovePlayerPaidEvenZero() {
 		ToX:       2,
 		ToY:       3,
 	})
-	// This is essentially checking that carol paid a 0 wager to the checkers module address.
-	suite.RequireBankBalance(balAlice, alice)
-	suite.RequireBankBalance(balBob, bob)
-	suite.RequireBankBalance(balCarol, carol)
-	suite.RequireBankBalance(0, checkersModuleAddress)
-}
-
-// Test setup that carol cannot pay because the balance is to large.
-func (suite *IntegrationTestSuite) TestPlayMoveCannotPayFails() {
-	suite.setupSuiteWithOneGameForPlayMove()
-	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
-		Creator: bob,
-		Black:   carol,
-		Red:     alice,
-		Wager:   balCarol + 1,
-	})
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob, bob)
 	suite.RequireBankBalance(balCarol, carol)
 	suite.RequireBankBalance(0, checkersModuleAddress)
-	playMoveResponse, err := suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
-		Creator:   carol,
-		GameIndex: "2",
-		FromX:     1,
-		FromY:     2,
-		ToX:       2,
-		ToY:       3,
-	})
-	suite.Require().Nil(playMoveResponse)
-	suite.Require().Equal("black cannot pay the wager: 10000000stake is smaller than 10000001stake: insufficient funds", err.Error())
 }
 
 func (suite *IntegrationTestSuite) TestPlayMoveEmitted() {
@@ -143,9 +126,9 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmitted() {
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
 
-	suite.Require().Len(events, 6)
+	suite.Require().Len(events, 7)
 
-	playEvent := events[3]
+	playEvent := events[4]
 
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "move-played",
@@ -159,13 +142,16 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmitted() {
 		},
 	}, playEvent)
 
-	transferEvent := events[5]
+	transferEvent := events[6]
 
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: checkersModuleAddress},
 		{Key: "sender", Value: bob},
 		{Key: "amount", Value: "45stake"},
+		{Key: "recipient", Value: checkersModuleAddress},
+		{Key: "sender", Value: carol},
+		{Key: "amount", Value: "45stake"},
 	}, transferEvent.Attributes)
 }
 
@@ -174,10 +160,14 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmittedEvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
-		Creator: bob,
+		Creator: carol,
 		Black:   carol,
 		Red:     alice,
-		Wager:   0,
+		Wager:   sdk.NewInt64Coin("stake", 0),
+	})
+	suite.msgServer.AcceptGame(goCtx, &types.MsgAcceptGame{
+		Creator:   alice,
+		GameIndex: "1",
 	})
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
 		Creator:   carol,
@@ -189,9 +179,9 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmittedEvenZero() {
 	})
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 6)
+	suite.Require().Len(events, 7)
 
-	playEvent := events[3]
+	playEvent := events[4]
 
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "move-played",
@@ -205,78 +195,18 @@ func (suite *IntegrationTestSuite) TestPlayMoveEmittedEvenZero() {
 		},
 	}, playEvent)
 
-	transferEvent := events[5]
+	transferEvent := events[6]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: checkersModuleAddress},
 		{Key: "sender", Value: carol},
 		{Key: "amount", Value: ""},
+		{Key: "recipient", Value: checkersModuleAddress},
+		{Key: "sender", Value: alice},
+		{Key: "amount", Value: ""},
 	}, transferEvent.Attributes)
 }
 
-// This tests if the both players paid
-func (suite *IntegrationTestSuite) TestPlayMove2PlayerPaid() {
-	suite.setupSuiteWithOneGameForPlayMove()
-	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
-		Creator:   bob,
-		GameIndex: "1",
-		FromX:     1,
-		FromY:     2,
-		ToX:       2,
-		ToY:       3,
-	})
-	suite.RequireBankBalance(balAlice, alice)
-	suite.RequireBankBalance(balBob-45, bob)
-	suite.RequireBankBalance(balCarol, carol)
-	suite.RequireBankBalance(45, checkersModuleAddress)
-	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
-		Creator:   carol,
-		GameIndex: "1",
-		FromX:     0,
-		FromY:     5,
-		ToX:       1,
-		ToY:       4,
-	})
-	suite.RequireBankBalance(balAlice, alice)
-	suite.RequireBankBalance(balBob-45, bob)
-	suite.RequireBankBalance(balCarol-45, carol)
-	suite.RequireBankBalance(90, checkersModuleAddress)
-}
-
-func (suite *IntegrationTestSuite) TestPlayMove2CannotPayFails() {
-	suite.setupSuiteWithOneGameForPlayMove()
-	goCtx := sdk.WrapSDKContext(suite.ctx)
-	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
-		Creator: alice,
-		Black:   bob,
-		Red:     carol,
-		Wager:   balCarol + 1,
-	})
-	suite.RequireBankBalance(balAlice, alice)
-	suite.RequireBankBalance(balBob, bob)
-	suite.RequireBankBalance(balCarol, carol)
-	suite.RequireBankBalance(0, checkersModuleAddress)
-	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
-		Creator:   bob,
-		GameIndex: "2",
-		FromX:     1,
-		FromY:     2,
-		ToX:       2,
-		ToY:       3,
-	})
-	playMoveResponse, err := suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
-		Creator:   carol,
-		GameIndex: "2",
-		FromX:     0,
-		FromY:     5,
-		ToX:       1,
-		ToY:       4,
-	})
-	suite.Require().Nil(playMoveResponse)
-	suite.Require().Equal("red cannot pay the wager: 10000000stake is smaller than 10000001stake: insufficient funds", err.Error())
-}
-
 func (suite *IntegrationTestSuite) TestPlayMove3DidNotPay() {
 	suite.setupSuiteWithOneGameForPlayMove()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
@@ -296,10 +226,6 @@ func (suite *IntegrationTestSuite) TestPlayMove3DidNotPay() {
 		ToX:       1,
 		ToY:       4,
 	})
-	suite.RequireBankBalance(balAlice, alice)
-	suite.RequireBankBalance(balBob-45, bob)
-	suite.RequireBankBalance(balCarol-45, carol)
-	suite.RequireBankBalance(90, checkersModuleAddress)
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -9,10 +9,10 @@ func (suite *IntegrationTestSuite) setupSuiteWithOneGameForRejectGame() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 }
 
@@ -56,6 +56,10 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedNoMoveNotPaid() {
 func (suite *IntegrationTestSuite) TestRejectedGameByRedOneMoveRefunded() {
 	suite.setupSuiteWithOneGameForRejectGame()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
+	suite.msgServer.AcceptGame(goCtx, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -66,8 +70,8 @@ func (suite *IntegrationTestSuite) TestRejectedGameByRedOneMoveRefunded() {
 	})
 	suite.RequireBankBalance(balAlice, alice)
 	suite.RequireBankBalance(balBob-45, bob)
-	suite.RequireBankBalance(balCarol, carol)
-	suite.RequireBankBalance(45, checkersModuleAddress)
+	suite.RequireBankBalance(balCarol-45, carol)
+	suite.RequireBankBalance(90, checkersModuleAddress)
 	suite.msgServer.RejectGame(goCtx, &types.MsgRejectGame{
 		Creator:   carol,
 		GameIndex: "1",
@@ -81,6 +85,10 @@ func (suite *IntegrationTestSuite) TestRejectedGameByRedOneMoveRefunded() {
 func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEmitted() {
 	suite.setupSuiteWithOneGameForRejectGame()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
+	suite.msgServer.AcceptGame(goCtx, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -94,9 +102,9 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEmitted() {
 		GameIndex: "1",
 	})
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 8)
 
-	rejectEvent := events[2]
+	rejectEvent := events[3]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-rejected",
 		Attributes: []sdk.Attribute{
@@ -105,23 +113,30 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEmitted() {
 		},
 	}, rejectEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[7]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: bob},
 		{Key: "sender", Value: checkersModuleAddress},
 		{Key: "amount", Value: "45stake"},
-	}, transferEvent.Attributes[3:])
+		{Key: "recipient", Value: carol},
+		{Key: "sender", Value: checkersModuleAddress},
+		{Key: "amount", Value: "45stake"},
+	}, transferEvent.Attributes[6:])
 }
 
 func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEvenZero() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     carol,
-		Wager:   0,
+		Wager:   sdk.NewInt64Coin("stake", 0),
+	})
+	suite.msgServer.AcceptGame(goCtx, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
 	})
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
 		Creator:   bob,
@@ -146,10 +161,14 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEvenZeroEmitted() {
 	suite.setupSuiteWithBalances()
 	goCtx := sdk.WrapSDKContext(suite.ctx)
 	suite.msgServer.CreateGame(goCtx, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     carol,
-		Wager:   0,
+		Wager:   sdk.NewInt64Coin("stake", 0),
+	})
+	suite.msgServer.AcceptGame(goCtx, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
 	})
 	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
 		Creator:   bob,
@@ -165,9 +184,9 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEvenZeroEmitted() {
 	})
 
 	events := sdk.StringifyEvents(suite.ctx.EventManager().ABCIEvents())
-	suite.Require().Len(events, 7)
+	suite.Require().Len(events, 8)
 
-	rejectEvent := events[2]
+	rejectEvent := events[3]
 	suite.Require().EqualValues(sdk.StringEvent{
 		Type: "game-rejected",
 		Attributes: []sdk.Attribute{
@@ -176,11 +195,14 @@ func (suite *IntegrationTestSuite) TestRejectGameByRedOneMoveEvenZeroEmitted() {
 		},
 	}, rejectEvent)
 
-	transferEvent := events[6]
+	transferEvent := events[7]
 	suite.Require().Equal(transferEvent.Type, "transfer")
 	suite.Require().EqualValues([]sdk.Attribute{
 		{Key: "recipient", Value: bob},
 		{Key: "sender", Value: checkersModuleAddress},
 		{Key: "amount", Value: ""},
-	}, transferEvent.Attributes[3:])
+		{Key: "recipient", Value: carol},
+		{Key: "sender", Value: checkersModuleAddress},
+		{Key: "amount", Value: ""},
+	}, transferEvent.Attributes[6:])
 }
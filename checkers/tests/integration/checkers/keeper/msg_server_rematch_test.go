@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *IntegrationTestSuite) TestAcceptRematchEscrowsBothWagers() {
+	suite.setupSuiteWithOneGameForDraw()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	suite.msgServer.Resign(goCtx, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	suite.msgServer.OfferRematch(goCtx, &types.MsgOfferRematch{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	_, err := suite.msgServer.AcceptRematch(goCtx, &types.MsgAcceptRematch{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	suite.Require().Nil(err)
+	suite.RequireBankBalance(balAlice, alice)
+	suite.RequireBankBalance(balBob-90, bob)
+	suite.RequireBankBalance(balCarol, carol)
+	suite.RequireBankBalance(90, checkersModuleAddress)
+}
+
+func (suite *IntegrationTestSuite) TestAcceptRematchOffererCannotPay() {
+	suite.setupSuiteWithOneGameForDraw()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	suite.msgServer.Resign(goCtx, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	suite.msgServer.OfferRematch(goCtx, &types.MsgOfferRematch{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	suite.RequireBankBalance(balBob-45, bob)
+	bobAddress, _ := sdk.AccAddressFromBech32(bob)
+	aliceAddress, _ := sdk.AccAddressFromBech32(alice)
+	suite.app.BankKeeper.SendCoins(suite.ctx, bobAddress, aliceAddress,
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(balBob-45))))
+	_, err := suite.msgServer.AcceptRematch(goCtx, &types.MsgAcceptRematch{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	suite.Require().NotNil(err)
+	suite.Require().Contains(err.Error(), "red cannot pay the wager")
+}
@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (suite *IntegrationTestSuite) TestResignNoMovePaid() {
+	suite.setupSuiteWithOneGameForDraw()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	_, err := suite.msgServer.Resign(goCtx, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	suite.Require().Nil(err)
+	suite.RequireBankBalance(balAlice, alice)
+	suite.RequireBankBalance(balBob-45, bob)
+	suite.RequireBankBalance(balCarol+45, carol)
+	suite.RequireBankBalance(0, checkersModuleAddress)
+}
+
+func (suite *IntegrationTestSuite) TestResignAfterMovePaid() {
+	suite.setupSuiteWithOneGameForDraw()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+	suite.msgServer.PlayMove(goCtx, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	_, err := suite.msgServer.Resign(goCtx, &types.MsgResign{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	suite.Require().Nil(err)
+	suite.RequireBankBalance(balAlice, alice)
+	suite.RequireBankBalance(balBob+45, bob)
+	suite.RequireBankBalance(balCarol-45, carol)
+	suite.RequireBankBalance(0, checkersModuleAddress)
+}
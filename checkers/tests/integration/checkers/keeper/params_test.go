@@ -0,0 +1,38 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+)
+
+func (suite *IntegrationTestSuite) TestParamChangeProposalSetsMaxTurnDuration() {
+	handler := params.NewParamChangeProposalHandler(suite.app.ParamsKeeper)
+	err := handler(suite.ctx, proposal.NewParameterChangeProposal("Longer turns", "One hour per turn", []proposal.ParamChange{
+		proposal.NewParamChange(types.ModuleName, string(types.KeyMaxTurnDuration), `"3600000000000"`),
+	}))
+	suite.Require().Nil(err)
+	suite.Require().Equal(time.Hour, suite.app.CheckersKeeper.MaxTurnDuration(suite.ctx))
+}
+
+func (suite *IntegrationTestSuite) TestParamChangeProposalSetsGas() {
+	handler := params.NewParamChangeProposalHandler(suite.app.ParamsKeeper)
+	err := handler(suite.ctx, proposal.NewParameterChangeProposal("Cheaper games", "Less gas to create", []proposal.ParamChange{
+		proposal.NewParamChange(types.ModuleName, string(types.KeyCreateGameGas), `"5000"`),
+		proposal.NewParamChange(types.ModuleName, string(types.KeyRejectGameRefundGas), `"4000"`),
+	}))
+	suite.Require().Nil(err)
+	suite.Require().EqualValues(5000, suite.app.CheckersKeeper.CreateGameGas(suite.ctx))
+	suite.Require().EqualValues(4000, suite.app.CheckersKeeper.RejectGameRefundGas(suite.ctx))
+}
+
+func (suite *IntegrationTestSuite) TestParamChangeProposalRejectsZeroTurnDuration() {
+	handler := params.NewParamChangeProposalHandler(suite.app.ParamsKeeper)
+	err := handler(suite.ctx, proposal.NewParameterChangeProposal("No time", "Zero per turn", []proposal.ParamChange{
+		proposal.NewParamChange(types.ModuleName, string(types.KeyMaxTurnDuration), `"0"`),
+	}))
+	suite.Require().NotNil(err)
+	suite.Require().Equal(types.DefaultMaxTurnDuration, suite.app.CheckersKeeper.MaxTurnDuration(suite.ctx))
+}
@@ -0,0 +1,206 @@
+// Package bot plays the games of an account of the local keyring, off chain, by watching the chain
+// for games where it is the account's turn and broadcasting its moves.
+package bot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	subscriber = "checkers-bot"
+	// Events are buffered while the bot waits for its own moves to be included.
+	eventCapacity = 100
+)
+
+// The events after which it may be the bot's turn in a game, with an attribute they all have.
+var watchedEvents = []struct {
+	eventType string
+	attribute string
+}{
+	{types.MovePlayedEventType, types.MovePlayedEventGameIndex},
+	{types.GameCreatedEventType, types.GameCreatedEventGameIndex},
+}
+
+type Bot struct {
+	clientCtx client.Context
+	txFactory tx.Factory
+	strategy  Strategy
+	logger    log.Logger
+	// Games can also come to the bot's turn without either watched event, as when they are
+	// accepted, so they are looked at this often too.
+	PollInterval time.Duration
+}
+
+// New returns a bot that plays for the from account of the client context. Each move is
+// broadcast in its own transaction, which has to be included in a block before the next one can
+// be signed, so the context broadcasts in block mode.
+func New(clientCtx client.Context, txFactory tx.Factory, strategy Strategy, logger log.Logger) *Bot {
+	return &Bot{
+		clientCtx:    clientCtx,
+		txFactory:    txFactory,
+		strategy:     strategy,
+		logger:       logger,
+		PollInterval: time.Minute,
+	}
+}
+
+// Run plays the bot's turns as they come, until the context is done.
+func (bot *Bot) Run(ctx context.Context) error {
+	if service, ok := bot.clientCtx.Client.(interface {
+		IsRunning() bool
+		Start() error
+	}); ok && !service.IsRunning() {
+		// The websocket of a remote node is only connected once started.
+		if err := service.Start(); err != nil {
+			return err
+		}
+	}
+	events := make(chan struct{}, eventCapacity)
+	for _, watched := range watchedEvents {
+		eventQuery := fmt.Sprintf("tm.event='Tx' AND %s.%s EXISTS", watched.eventType, watched.attribute)
+		out, err := bot.clientCtx.Client.Subscribe(ctx, subscriber, eventQuery, eventCapacity)
+		if err != nil {
+			return err
+		}
+		go func() {
+			for range out {
+				select {
+				case events <- struct{}{}:
+				default:
+					// A pass is already due, it will see this game too.
+				}
+			}
+		}()
+	}
+	defer bot.clientCtx.Client.UnsubscribeAll(context.Background(), subscriber)
+
+	ticker := time.NewTicker(bot.PollInterval)
+	defer ticker.Stop()
+	for {
+		played, err := bot.PlayTurns(ctx)
+		if err != nil {
+			bot.logger.Error("cannot list games", "err", err)
+		} else if len(played) > 0 {
+			bot.logger.Info("played", "games", played)
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-events:
+		case <-ticker.C:
+		}
+	}
+}
+
+// PlayTurns moves in each active game of the bot where it is its turn, and returns the indices of
+// the games it moved in. A game it cannot move in is logged and skipped, as are those past their
+// deadline, which are about to be forfeited.
+func (bot *Bot) PlayTurns(ctx context.Context) (played []string, err error) {
+	queryClient := types.NewQueryClient(bot.clientCtx)
+	address := bot.clientCtx.GetFromAddress().String()
+	var nextKey []byte
+	for {
+		res, err := queryClient.GamesByPlayer(ctx, &types.QueryGamesByPlayerRequest{
+			Address:    address,
+			Status:     types.GameStatusActive,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return played, err
+		}
+		for _, storedGame := range res.StoredGames {
+			if !bot.isTurn(storedGame, address) {
+				continue
+			}
+			if err := bot.playTurn(storedGame); err != nil {
+				bot.logger.Error("cannot play", "game", storedGame.Index, "err", err)
+				continue
+			}
+			played = append(played, storedGame.Index)
+		}
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return played, nil
+		}
+		nextKey = res.Pagination.NextKey
+	}
+}
+
+func (bot *Bot) isTurn(storedGame types.StoredGame, address string) bool {
+	if storedGame.Turn == rules.PieceStrings[rules.BLACK_PLAYER] {
+		return storedGame.Black == address
+	}
+	return storedGame.Turn == rules.PieceStrings[rules.RED_PLAYER] && storedGame.Red == address
+}
+
+func (bot *Bot) playTurn(storedGame types.StoredGame) error {
+	deadline, err := storedGame.GetDeadlineAsTime()
+	if err != nil {
+		return err
+	}
+	if !time.Now().Before(deadline) {
+		return errors.New(fmt.Sprintf("deadline passed: %s", storedGame.Deadline))
+	}
+	game, err := storedGame.ParseGame()
+	if err != nil {
+		return err
+	}
+	path, err := bot.strategy.ChooseMove(game)
+	if err != nil {
+		return err
+	}
+	// A multi-jump is played whole, in a single message.
+	positions := make([]types.Position, len(path))
+	for i, pos := range path {
+		positions[i] = types.Position{X: uint64(pos.X), Y: uint64(pos.Y)}
+	}
+	return bot.broadcast(types.NewMsgPlayMoves(bot.clientCtx.GetFromAddress().String(), storedGame.Index, positions))
+}
+
+// Signs and broadcasts the messages like tx.BroadcastTx, without asking for confirmation and
+// failing when the transaction does.
+func (bot *Bot) broadcast(msgs ...sdk.Msg) error {
+	from := bot.clientCtx.GetFromAddress()
+	txf := bot.txFactory
+	number, sequence, err := txf.AccountRetriever().GetAccountNumberSequence(bot.clientCtx, from)
+	if err != nil {
+		return err
+	}
+	txf = txf.WithAccountNumber(number).WithSequence(sequence)
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(bot.clientCtx, txf, msgs...)
+		if err != nil {
+			return err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+	txBuilder, err := tx.BuildUnsignedTx(txf, msgs...)
+	if err != nil {
+		return err
+	}
+	if err := tx.Sign(txf, bot.clientCtx.GetFromName(), txBuilder, true); err != nil {
+		return err
+	}
+	txBytes, err := bot.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+	res, err := bot.clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+	if res.Code != 0 {
+		return errors.New(fmt.Sprintf("transaction failed with code %d: %s", res.Code, res.RawLog))
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package bot_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/alice/checkers/testutil/network"
+	"github.com/alice/checkers/x/checkers/client/bot"
+	"github.com/alice/checkers/x/checkers/client/cli"
+	"github.com/alice/checkers/x/checkers/types"
+)
+
+func TestBotPlaysItsGames(t *testing.T) {
+	net := network.New(t)
+	val := net.Validators[0]
+	fees := sdk.NewCoins(sdk.NewCoin(net.Config.BondDenom, sdk.NewInt(10)))
+	// The validator plays both sides, so that it is always the bot's turn.
+	_, err := clitestutil.ExecTestCLICmd(val.ClientCtx, cli.CmdCreateGame(), []string{
+		val.Address.String(), val.Address.String(), sdk.NewCoin(net.Config.BondDenom, sdk.ZeroInt()).String(),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, val.Address.String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, fees.String()),
+	})
+	require.NoError(t, err)
+
+	clientCtx := val.ClientCtx.
+		WithFromAddress(val.Address).
+		WithFromName(val.Moniker).
+		WithBroadcastMode(flags.BroadcastBlock)
+	txFactory := tx.Factory{}.
+		WithChainID(clientCtx.ChainID).
+		WithKeybase(clientCtx.Keyring).
+		WithTxConfig(clientCtx.TxConfig).
+		WithAccountRetriever(clientCtx.AccountRetriever).
+		WithGas(flags.DefaultGasLimit).
+		WithFees(fees.String())
+	player := bot.New(clientCtx, txFactory, bot.NewRandomStrategy(1), log.NewNopLogger())
+	queryClient := types.NewQueryClient(clientCtx)
+	moveCount := func() uint64 {
+		res, err := queryClient.StoredGame(context.Background(), &types.QueryGetStoredGameRequest{Index: "1"})
+		require.NoError(t, err)
+		return res.StoredGame.MoveCount
+	}
+
+	played, err := player.PlayTurns(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, played)
+	require.EqualValues(t, 1, moveCount())
+
+	// Each move the bot plays has it play the next one.
+	player.PollInterval = time.Hour
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- player.Run(ctx)
+	}()
+	require.Eventually(t, func() bool { return 4 <= moveCount() }, time.Minute, time.Second)
+	cancel()
+	require.NoError(t, <-done)
+}
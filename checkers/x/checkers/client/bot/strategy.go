@@ -0,0 +1,55 @@
+package bot
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/rules/engine"
+)
+
+const (
+	StrategyRandom = "random"
+	StrategyEngine = "engine"
+)
+
+// Strategy picks the path the bot moves a piece along on its turn, a multi-jump taking several
+// hops.
+type Strategy interface {
+	ChooseMove(game *rules.Game) (path []rules.Pos, err error)
+}
+
+// RandomStrategy plays any of the legal moves, each as likely.
+type RandomStrategy struct {
+	Rand *rand.Rand
+}
+
+func NewRandomStrategy(seed int64) *RandomStrategy {
+	return &RandomStrategy{Rand: rand.New(rand.NewSource(seed))}
+}
+
+func (strategy *RandomStrategy) ChooseMove(game *rules.Game) (path []rules.Pos, err error) {
+	paths := engine.TurnPaths(game)
+	if len(paths) == 0 {
+		return nil, errors.New(fmt.Sprintf("No legal move for %v", game.Turn))
+	}
+	return paths[strategy.Rand.Intn(len(paths))], nil
+}
+
+// EngineStrategy plays the move the engine finds best.
+type EngineStrategy struct {
+	Engine *engine.Engine
+}
+
+func NewEngineStrategy(depth int) *EngineStrategy {
+	return &EngineStrategy{Engine: engine.New(depth)}
+}
+
+func (strategy *EngineStrategy) ChooseMove(game *rules.Game) (path []rules.Pos, err error) {
+	suggestion, err := strategy.Engine.BestMove(game)
+	if err != nil {
+		return nil, err
+	}
+	return suggestion.Path, nil
+}
@@ -0,0 +1,35 @@
+package bot_test
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/rules/engine"
+	"github.com/alice/checkers/x/checkers/client/bot"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRandomStrategyPlaysLegalMoves(t *testing.T) {
+	strategy := bot.NewRandomStrategy(1)
+	game := rules.New()
+	for i := 0; i < 10; i++ {
+		path, err := strategy.ChooseMove(game)
+		require.Nil(t, err)
+		require.Contains(t, engine.TurnPaths(game), path)
+		_, err = game.MoveAlong(path)
+		require.Nil(t, err)
+	}
+}
+
+func TestRandomStrategyNoMove(t *testing.T) {
+	game, err := rules.Parse("********|********|********|********|********|********|********|r*******")
+	require.Nil(t, err)
+	_, err = bot.NewRandomStrategy(1).ChooseMove(game)
+	require.EqualError(t, err, "No legal move for {black}")
+}
+
+func TestEngineStrategyPlaysBestMove(t *testing.T) {
+	path, err := bot.NewEngineStrategy(4).ChooseMove(rules.New())
+	require.Nil(t, err)
+	require.Equal(t, []rules.Pos{{X: 1, Y: 2}, {X: 0, Y: 3}}, path)
+}
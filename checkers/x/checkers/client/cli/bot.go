@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/alice/checkers/rules/engine"
+	"github.com/alice/checkers/x/checkers/client/bot"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	FlagStrategy     = "strategy"
+	FlagPollInterval = "poll-interval"
+)
+
+// CmdBot groups the commands that play games for an account of the keyring.
+func CmdBot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "bot",
+		Short:                      "Let a bot play the checkers games of an account",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdBotRun())
+
+	return cmd
+}
+
+func CmdBotRun() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Play the moves of the from account in its active games, until interrupted",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			strategyName, err := cmd.Flags().GetString(FlagStrategy)
+			if err != nil {
+				return err
+			}
+			depth, err := cmd.Flags().GetInt(FlagDepth)
+			if err != nil {
+				return err
+			}
+			pollInterval, err := cmd.Flags().GetDuration(FlagPollInterval)
+			if err != nil {
+				return err
+			}
+			var strategy bot.Strategy
+			switch strategyName {
+			case bot.StrategyRandom:
+				strategy = bot.NewRandomStrategy(time.Now().UnixNano())
+			case bot.StrategyEngine:
+				if depth < 1 || engine.MaxDepth < depth {
+					return fmt.Errorf("depth out of range 1 to %d: %d", engine.MaxDepth, depth)
+				}
+				strategy = bot.NewEngineStrategy(depth)
+			default:
+				return fmt.Errorf("unknown strategy: %s", strategyName)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientCtx = clientCtx.WithBroadcastMode(flags.BroadcastBlock)
+
+			player := bot.New(clientCtx, tx.NewFactoryCLI(clientCtx, cmd.Flags()), strategy,
+				log.NewTMLogger(log.NewSyncWriter(cmd.ErrOrStderr())).With("module", "checkers-bot"))
+			player.PollInterval = pollInterval
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			return player.Run(ctx)
+		},
+	}
+
+	cmd.Flags().String(FlagStrategy, bot.StrategyEngine, fmt.Sprintf("How the bot picks its moves, %s or %s", bot.StrategyRandom, bot.StrategyEngine))
+	cmd.Flags().Int(FlagDepth, engine.DefaultDepth, fmt.Sprintf("How many turns ahead the engine searches, up to %d", engine.MaxDepth))
+	cmd.Flags().Duration(FlagPollInterval, time.Minute, "How often to look for games to play besides when moves are played or games created")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/rules/engine"
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagDepth = "depth"
+)
+
+// CmdEngine groups the commands that run the engine on the client, without sending anything to
+// the chain.
+func CmdEngine() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "engine",
+		Short:                      "Ask the checkers engine about games, off chain",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdEngineSuggest())
+
+	return cmd
+}
+
+func CmdEngineSuggest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "suggest [index]",
+		Short: "Print the move the engine recommends to the player whose turn it is in a game",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			depth, err := cmd.Flags().GetInt(FlagDepth)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGetStoredGameRequest{
+				Index: args[0],
+			}
+
+			res, err := queryClient.StoredGame(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+			if res.StoredGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+				return types.ErrGameFinished
+			}
+			game, err := res.StoredGame.ParseGame()
+			if err != nil {
+				return err
+			}
+			suggestion, err := engine.New(depth).BestMove(game)
+			if err != nil {
+				return err
+			}
+
+			// The path is printed as play-moves takes it.
+			return clientCtx.PrintString(fmt.Sprintf("move: %s\nscore: %d\n", formatPath(suggestion.Path), suggestion.Score))
+		},
+	}
+
+	cmd.Flags().Int(FlagDepth, engine.DefaultDepth, fmt.Sprintf("How many turns ahead to search, up to %d", engine.MaxDepth))
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func formatPath(path []rules.Pos) string {
+	positions := make([]string, 0, len(path))
+	for _, pos := range path {
+		positions = append(positions, fmt.Sprintf("%d%s%d", pos.X, listSeparator, pos.Y))
+	}
+	return strings.Join(positions, " ")
+}
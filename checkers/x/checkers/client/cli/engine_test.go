@@ -0,0 +1,37 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/testutil/network"
+	"github.com/alice/checkers/x/checkers/client/cli"
+	"github.com/alice/checkers/x/checkers/types"
+)
+
+func TestEngineSuggest(t *testing.T) {
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+	board := rules.New().String()
+	state.StoredGameList = append(state.StoredGameList,
+		types.StoredGame{Index: "1", Board: board, Turn: "b", Winner: "*"},
+		types.StoredGame{Index: "2", Board: board, Turn: "b", Winner: "r"},
+	)
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	net := network.New(t, cfg)
+	ctx := net.Validators[0].ClientCtx
+
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdEngineSuggest(), []string{"1", fmt.Sprintf("--%s=4", cli.FlagDepth)})
+	require.NoError(t, err)
+	require.Equal(t, "move: 1,2 0,3\nscore: -14\n", out.String())
+
+	_, err = clitestutil.ExecTestCLICmd(ctx, cli.CmdEngineSuggest(), []string{"2"})
+	require.ErrorIs(t, err, types.ErrGameFinished)
+}
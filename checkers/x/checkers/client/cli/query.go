@@ -28,8 +28,19 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowSystemInfo())
 	cmd.AddCommand(CmdListStoredGame())
 	cmd.AddCommand(CmdShowStoredGame())
+	cmd.AddCommand(CmdGamesByPlayer())
 	cmd.AddCommand(CmdCanPlayMove())
-
+	cmd.AddCommand(CmdLegalMoves())
+	cmd.AddCommand(CmdGameMoves())
+	cmd.AddCommand(CmdExportPdn())
+	cmd.AddCommand(CmdOpenChallenges())
+
+	cmd.AddCommand(CmdListPlayerInfo())
+	cmd.AddCommand(CmdShowPlayerInfo())
+	cmd.AddCommand(CmdShowLeaderboard())
+	cmd.AddCommand(CmdListTournament())
+	cmd.AddCommand(CmdShowTournament())
+	cmd.AddCommand(CmdTournamentStandings())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
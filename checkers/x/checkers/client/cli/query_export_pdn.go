@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+func CmdExportPdn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-pdn [index]",
+		Short: "Export a game in Portable Draughts Notation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryExportPdnRequest{
+				GameIndex: args[0],
+			}
+
+			res, err := queryClient.ExportPdn(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			// Print the file as is, so that it can be redirected to a .pdn file.
+			return clientCtx.PrintString(res.Pdn)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
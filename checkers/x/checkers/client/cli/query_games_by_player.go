@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagStatus = "status"
+)
+
+func CmdGamesByPlayer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "games-by-player [address]",
+		Short: "list the games of a player",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reqAddress := args[0]
+			reqStatus, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryGamesByPlayerRequest{
+				Address:    reqAddress,
+				Status:     reqStatus,
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.GamesByPlayer(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagStatus, "", "Only list the games with this status, one of pending, active, finished, forfeited or drawn")
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
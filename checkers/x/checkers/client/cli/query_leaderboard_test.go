@@ -0,0 +1,51 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/stretchr/testify/require"
+	tmcli "github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/alice/checkers/testutil/network"
+	"github.com/alice/checkers/testutil/sample"
+	"github.com/alice/checkers/x/checkers/client/cli"
+	"github.com/alice/checkers/x/checkers/types"
+)
+
+func networkWithLeaderboardObjects(t *testing.T) (*network.Network, types.Leaderboard) {
+	t.Helper()
+	cfg := network.DefaultConfig()
+	state := types.GenesisState{}
+	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
+
+	state.Leaderboard = types.Leaderboard{
+		Winners: []types.WinningPlayer{
+			{
+				PlayerAddress: sample.AccAddress(),
+				EloRating:     1216,
+				WonCount:      1,
+				DateAdded:     types.DeadlineLayout,
+			},
+		},
+	}
+	buf, err := cfg.Codec.MarshalJSON(&state)
+	require.NoError(t, err)
+	cfg.GenesisState[types.ModuleName] = buf
+	return network.New(t, cfg), state.Leaderboard
+}
+
+func TestShowLeaderboard(t *testing.T) {
+	net, obj := networkWithLeaderboardObjects(t)
+
+	ctx := net.Validators[0].ClientCtx
+	args := []string{
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	}
+	out, err := clitestutil.ExecTestCLICmd(ctx, cli.CmdShowLeaderboard(), args)
+	require.NoError(t, err)
+	var resp types.QueryGetLeaderboardResponse
+	require.NoError(t, net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Equal(t, obj, resp.Leaderboard)
+}
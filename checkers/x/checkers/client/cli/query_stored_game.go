@@ -9,11 +9,41 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagMinWager = "min-wager"
+	FlagMaxWager = "max-wager"
+	FlagTurn     = "turn"
+	FlagSortBy   = "sort-by"
+)
+
 func CmdListStoredGame() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-stored-game",
 		Short: "list all storedGame",
 		RunE: func(cmd *cobra.Command, args []string) error {
+			reqStatus, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+			reqMinWager, err := cmd.Flags().GetUint64(FlagMinWager)
+			if err != nil {
+				return err
+			}
+			reqMaxWager, err := cmd.Flags().GetUint64(FlagMaxWager)
+			if err != nil {
+				return err
+			}
+			// A max wager of zero given on the command line is a bound all the same.
+			reqHasMaxWager := cmd.Flags().Changed(FlagMaxWager)
+			reqTurn, err := cmd.Flags().GetString(FlagTurn)
+			if err != nil {
+				return err
+			}
+			reqSortBy, err := cmd.Flags().GetString(FlagSortBy)
+			if err != nil {
+				return err
+			}
+
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
@@ -24,7 +54,13 @@ func CmdListStoredGame() *cobra.Command {
 			queryClient := types.NewQueryClient(clientCtx)
 
 			params := &types.QueryAllStoredGameRequest{
-				Pagination: pageReq,
+				Pagination:  pageReq,
+				Status:      reqStatus,
+				MinWager:    reqMinWager,
+				MaxWager:    reqMaxWager,
+				HasMaxWager: reqHasMaxWager,
+				Turn:        reqTurn,
+				SortBy:      reqSortBy,
 			}
 
 			res, err := queryClient.StoredGameAll(context.Background(), params)
@@ -36,6 +72,11 @@ func CmdListStoredGame() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagStatus, "", "Only list the games with this status, one of pending, active, finished, forfeited or drawn")
+	cmd.Flags().Uint64(FlagMinWager, 0, "Only list the games with at least this wager")
+	cmd.Flags().Uint64(FlagMaxWager, 0, "Only list the games with at most this wager")
+	cmd.Flags().String(FlagTurn, "", "Only list the games with this color to play, b or r")
+	cmd.Flags().String(FlagSortBy, "", "List the games in this order, created or deadline, but for a wager range, listed by wager")
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
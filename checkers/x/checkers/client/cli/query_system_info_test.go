@@ -21,7 +21,9 @@ func networkWithSystemInfoObjects(t *testing.T) (*network.Network, types.SystemI
 	state := types.GenesisState{}
 	require.NoError(t, cfg.Codec.UnmarshalJSON(cfg.GenesisState[types.ModuleName], &state))
 
-	systemInfo := types.SystemInfo{}
+	systemInfo := types.SystemInfo{
+		NextId: types.DefaultIndex,
+	}
 	nullify.Fill(&systemInfo)
 	state.SystemInfo = systemInfo
 	buf, err := cfg.Codec.MarshalJSON(&state)
@@ -33,6 +33,20 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateGame())
 	cmd.AddCommand(CmdPlayMove())
 	cmd.AddCommand(CmdRejectGame())
+	cmd.AddCommand(CmdOfferDraw())
+	cmd.AddCommand(CmdAcceptDraw())
+	cmd.AddCommand(CmdDeclineDraw())
+	cmd.AddCommand(CmdPlayMoves())
+	cmd.AddCommand(CmdCreateChallenge())
+	cmd.AddCommand(CmdAcceptChallenge())
+	cmd.AddCommand(CmdAcceptGame())
+	cmd.AddCommand(CmdCreateTournament())
+	cmd.AddCommand(CmdJoinTournament())
+	cmd.AddCommand(CmdResign())
+	cmd.AddCommand(CmdRequestTakeback())
+	cmd.AddCommand(CmdAcceptTakeback())
+	cmd.AddCommand(CmdOfferRematch())
+	cmd.AddCommand(CmdAcceptRematch())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdCreateChallenge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-challenge [color] [wager] [variant]",
+		Short: "Broadcast message createChallenge, to open a game in which you play color b or r",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argColor := args[0]
+			argWager, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argVariant := ""
+			if len(args) > 2 {
+				argVariant = args[2]
+			}
+			argDenom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateChallenge(
+				clientCtx.GetFromAddress().String(),
+				argColor,
+				argWager,
+				argVariant,
+				argDenom,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "Denomination of the wager, such as an IBC voucher, instead of the staking one")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -7,20 +7,49 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 )
 
 var _ = strconv.Itoa(0)
 
+const (
+	FlagFen       = "fen"
+	FlagDenom     = "denom"
+	FlagTurnTime  = "turn-time"
+	FlagClock     = "clock"
+	FlagIncrement = "increment"
+)
+
 func CmdCreateGame() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "create-game [black] [red] [wager]",
-		Short: "Broadcast message createGame",
-		Args:  cobra.ExactArgs(3),
+		Use:   "create-game [black] [red] [wager] [variant]",
+		Short: "Broadcast message createGame, wagering a coin such as 0stake, played with American rules unless another variant is given",
+		Args:  cobra.RangeArgs(3, 4),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argBlack := args[0]
 			argRed := args[1]
-			argWager, err := strconv.ParseUint(args[2], 10, 64)
+			argWager, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+			argVariant := ""
+			if len(args) > 3 {
+				argVariant = args[3]
+			}
+			argFen, err := cmd.Flags().GetString(FlagFen)
+			if err != nil {
+				return err
+			}
+			argTurnTime, err := cmd.Flags().GetDuration(FlagTurnTime)
+			if err != nil {
+				return err
+			}
+			argClock, err := cmd.Flags().GetDuration(FlagClock)
+			if err != nil {
+				return err
+			}
+			argIncrement, err := cmd.Flags().GetDuration(FlagIncrement)
 			if err != nil {
 				return err
 			}
@@ -35,6 +64,13 @@ func CmdCreateGame() *cobra.Command {
 				argBlack,
 				argRed,
 				argWager,
+				argVariant,
+				argFen,
+				types.TimeControl{
+					TurnDuration: argTurnTime,
+					Clock:        argClock,
+					Increment:    argIncrement,
+				},
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -43,6 +79,10 @@ func CmdCreateGame() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagFen, "", "Start from this position, in FEN, instead of the usual one")
+	cmd.Flags().Duration(FlagTurnTime, 0, "Time each player has for a move, instead of the max turn duration param")
+	cmd.Flags().Duration(FlagClock, 0, "Time each player has for the whole game, instead of a time per move")
+	cmd.Flags().Duration(FlagIncrement, 0, "Time added to the player's clock after each of their moves")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdCreateTournament() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-tournament [format] [entry-fee] [max-players] [start-time]",
+		Short: "Broadcast message createTournament, to open a single-elimination or round-robin tournament starting at start-time, such as \"2006-01-02 15:04:05 +0000 UTC\"",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argFormat := args[0]
+			argEntryFee, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			argMaxPlayers, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			argStartTime := args[3]
+			argDenom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateTournament(
+				clientCtx.GetFromAddress().String(),
+				argFormat,
+				argEntryFee,
+				argDenom,
+				argMaxPlayers,
+				argStartTime,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "Denomination of the entry fee, such as an IBC voucher, instead of the staking one")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
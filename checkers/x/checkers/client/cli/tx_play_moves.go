@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+)
+
+var _ = strconv.Itoa(0)
+
+func CmdPlayMoves() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "play-moves [game-index] [x,y] [x,y]...",
+		Short: "Broadcast message playMoves, to move a piece along a path of positions in one go",
+		Args:  cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argGameIndex := args[0]
+			argPath := make([]types.Position, 0, len(args)-1)
+			for _, arg := range args[1:] {
+				position, err := parsePosition(arg)
+				if err != nil {
+					return err
+				}
+				argPath = append(argPath, position)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlayMoves(
+				clientCtx.GetFromAddress().String(),
+				argGameIndex,
+				argPath,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parsePosition(arg string) (position types.Position, err error) {
+	coordinates := strings.Split(arg, listSeparator)
+	if len(coordinates) != 2 {
+		return position, fmt.Errorf("position must be x%sy: %s", listSeparator, arg)
+	}
+	position.X, err = cast.ToUint64E(coordinates[0])
+	if err != nil {
+		return position, err
+	}
+	position.Y, err = cast.ToUint64E(coordinates[1])
+	return position, err
+}
@@ -17,6 +17,27 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.StoredGameList {
 		k.SetStoredGame(ctx, elem)
 	}
+	// Set all the playerInfo
+	for _, elem := range genState.PlayerInfoList {
+		k.SetPlayerInfo(ctx, elem)
+	}
+	k.SetLeaderboard(ctx, genState.Leaderboard)
+	// Set all the leaderboardCandidate
+	for _, elem := range genState.LeaderboardCandidateList {
+		k.SetLeaderboardCandidate(ctx, elem)
+	}
+	// Set all the gameMove
+	for _, elem := range genState.GameMoveList {
+		k.AppendGameMove(ctx, elem)
+	}
+	// Set all the challenge
+	for _, elem := range genState.ChallengeList {
+		k.SetChallenge(ctx, elem)
+	}
+	// Set all the tournament, which also fills the start time index
+	for _, elem := range genState.TournamentList {
+		k.SetTournament(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -32,6 +53,17 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.SystemInfo = systemInfo
 	}
 	genesis.StoredGameList = k.GetAllStoredGame(ctx)
+	genesis.PlayerInfoList = k.GetAllPlayerInfo(ctx)
+	leaderboard, found := k.GetLeaderboard(ctx)
+	if found {
+		genesis.Leaderboard = leaderboard
+	}
+	genesis.LeaderboardCandidateList = k.GetAllLeaderboardCandidate(ctx)
+	for _, storedGame := range genesis.StoredGameList {
+		genesis.GameMoveList = append(genesis.GameMoveList, k.GetAllGameMove(ctx, storedGame.Index)...)
+	}
+	genesis.ChallengeList = k.GetAllChallenge(ctx)
+	genesis.TournamentList = k.GetAllTournament(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
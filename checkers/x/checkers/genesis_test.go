@@ -2,11 +2,14 @@ package checkers_test
 
 import (
 	"testing"
+	"time"
 
 	keepertest "github.com/alice/checkers/testutil/keeper"
 	"github.com/alice/checkers/testutil/nullify"
 	"github.com/alice/checkers/x/checkers"
+	"github.com/alice/checkers/x/checkers/testutil"
 	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -18,6 +21,16 @@ func TestGenesis(t *testing.T) {
 			NextId: 34,
 		},
 		StoredGameList: []types.StoredGame{
+			{
+				Index:     "0",
+				MoveCount: 2,
+			},
+			{
+				Index:     "1",
+				MoveCount: 1,
+			},
+		},
+		PlayerInfoList: []types.PlayerInfo{
 			{
 				Index: "0",
 			},
@@ -25,6 +38,55 @@ func TestGenesis(t *testing.T) {
 				Index: "1",
 			},
 		},
+		Leaderboard: types.Leaderboard{
+			Winners: []types.WinningPlayer{
+				{
+					PlayerAddress: testutil.Alice,
+					EloRating:     1216,
+					WonCount:      1,
+					DateAdded:     types.DeadlineLayout,
+				},
+			},
+		},
+		GameMoveList: []types.GameMove{
+			{
+				GameIndex: "0",
+				MoveIndex: 0,
+			},
+			{
+				GameIndex: "0",
+				MoveIndex: 1,
+			},
+			{
+				GameIndex: "1",
+				MoveIndex: 0,
+			},
+		},
+		ChallengeList: []types.Challenge{
+			{
+				Index:    "32",
+				Creator:  testutil.Alice,
+				Deadline: types.DeadlineLayout,
+			},
+			{
+				Index:    "33",
+				Creator:  testutil.Bob,
+				Deadline: types.DeadlineLayout,
+			},
+		},
+		TournamentList: []types.Tournament{
+			{
+				Index:     "30",
+				Status:    types.TournamentStatusFinished,
+				StartTime: types.DeadlineLayout,
+			},
+			{
+				Index:     "31",
+				Status:    types.TournamentStatusOpen,
+				StartTime: types.DeadlineLayout,
+			},
+		},
+		LeaderboardCandidateList: []string{"1"},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -38,7 +100,64 @@ func TestGenesis(t *testing.T) {
 
 	require.Equal(t, genesisState.SystemInfo, got.SystemInfo)
 	require.ElementsMatch(t, genesisState.StoredGameList, got.StoredGameList)
+	require.ElementsMatch(t, genesisState.PlayerInfoList, got.PlayerInfoList)
+	require.Equal(t, genesisState.Leaderboard, got.Leaderboard)
+	require.ElementsMatch(t, genesisState.GameMoveList, got.GameMoveList)
+	require.ElementsMatch(t, genesisState.ChallengeList, got.ChallengeList)
+	require.ElementsMatch(t, genesisState.TournamentList, got.TournamentList)
+	require.ElementsMatch(t, genesisState.LeaderboardCandidateList, got.LeaderboardCandidateList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
 
+func TestGenesisRebuildsTournamentStartIndex(t *testing.T) {
+	genesisState := types.DefaultGenesis()
+	genesisState.TournamentList = []types.Tournament{
+		{
+			Index:     "1",
+			Status:    types.TournamentStatusFinished,
+			StartTime: types.DeadlineLayout,
+		},
+		{
+			Index:     "2",
+			Status:    types.TournamentStatusOpen,
+			StartTime: types.DeadlineLayout,
+		},
+	}
+
+	k, ctx := keepertest.CheckersKeeper(t)
+	checkers.InitGenesis(ctx, *k, *genesisState)
+
+	afterStart := ctx.WithBlockTime(time.Date(2006, 1, 3, 0, 0, 0, 0, time.UTC))
+	require.Equal(t, []string{"2"}, k.GetTournamentIndicesToStart(afterStart))
+}
+
 // This test checks if the genesis state equals uint 1
+
+func TestGenesisKeepsLeaderboardCandidatesForTheNextRefresh(t *testing.T) {
+	genesisState := types.DefaultGenesis()
+	genesisState.PlayerInfoList = []types.PlayerInfo{
+		{
+			Index:     testutil.Alice,
+			WonCount:  1,
+			EloRating: 1216,
+		},
+	}
+	genesisState.LeaderboardCandidateList = []string{testutil.Alice}
+
+	k, ctx := keepertest.CheckersKeeper(t)
+	checkers.InitGenesis(ctx, *k, *genesisState)
+	refreshCtx := ctx.WithBlockHeight(int64(genesisState.Params.LeaderboardRefreshInterval))
+	k.RefreshLeaderboard(sdk.WrapSDKContext(refreshCtx))
+
+	leaderboard, found := k.GetLeaderboard(ctx)
+	require.True(t, found)
+	require.Equal(t, []types.WinningPlayer{
+		{
+			PlayerAddress: testutil.Alice,
+			EloRating:     1216,
+			WonCount:      1,
+			DateAdded:     types.FormatDeadline(refreshCtx.BlockTime()),
+		},
+	}, leaderboard.Winners)
+	require.Empty(t, k.GetAllLeaderboardCandidate(ctx))
+}
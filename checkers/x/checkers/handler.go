@@ -26,6 +26,48 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgRejectGame:
 			res, err := msgServer.RejectGame(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgOfferDraw:
+			res, err := msgServer.OfferDraw(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAcceptDraw:
+			res, err := msgServer.AcceptDraw(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgDeclineDraw:
+			res, err := msgServer.DeclineDraw(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgPlayMoves:
+			res, err := msgServer.PlayMoves(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCreateChallenge:
+			res, err := msgServer.CreateChallenge(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAcceptChallenge:
+			res, err := msgServer.AcceptChallenge(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAcceptGame:
+			res, err := msgServer.AcceptGame(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgCreateTournament:
+			res, err := msgServer.CreateTournament(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgJoinTournament:
+			res, err := msgServer.JoinTournament(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgResign:
+			res, err := msgServer.Resign(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgRequestTakeback:
+			res, err := msgServer.RequestTakeback(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAcceptTakeback:
+			res, err := msgServer.AcceptTakeback(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgOfferRematch:
+			res, err := msgServer.OfferRematch(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgAcceptRematch:
+			res, err := msgServer.AcceptRematch(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 			// this line is used by starport scaffolding # 1
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
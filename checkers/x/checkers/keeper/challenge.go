@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetChallenge set a specific challenge in the store from its index
+func (k Keeper) SetChallenge(ctx sdk.Context, challenge types.Challenge) {
+	key, ok := types.ChallengeKey(challenge.Index)
+	if !ok {
+		panic("Challenge index is not a number " + challenge.Index)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChallengeKeyPrefix))
+	b := k.cdc.MustMarshal(&challenge)
+	store.Set(key, b)
+}
+
+// GetChallenge returns a challenge from its index
+func (k Keeper) GetChallenge(
+	ctx sdk.Context,
+	index string,
+
+) (val types.Challenge, found bool) {
+	key, ok := types.ChallengeKey(index)
+	if !ok {
+		return val, false
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChallengeKeyPrefix))
+
+	b := store.Get(key)
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveChallenge removes a challenge from the store
+func (k Keeper) RemoveChallenge(
+	ctx sdk.Context,
+	index string,
+
+) {
+	key, ok := types.ChallengeKey(index)
+	if !ok {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChallengeKeyPrefix))
+	store.Delete(key)
+}
+
+// GetAllChallenge returns all challenge, oldest first
+func (k Keeper) GetAllChallenge(ctx sdk.Context) (list []types.Challenge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChallengeKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Challenge
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
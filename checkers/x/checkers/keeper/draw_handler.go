@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Fetches the game whose pending draw offer the creator wants to accept or decline.
+func (k Keeper) getDrawOfferToAnswer(ctx sdk.Context, gameIndex string, creator string) (storedGame types.StoredGame, err error) {
+	storedGame, found := k.GetStoredGame(ctx, gameIndex)
+	if !found {
+		return storedGame, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", gameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return storedGame, types.ErrGameFinished
+	}
+	if err := checkOfferToAnswer(storedGame, creator, storedGame.DrawOffer, types.ErrNoDrawOffer, types.ErrCannotAnswerOwnDraw); err != nil {
+		return storedGame, err
+	}
+	return storedGame, nil
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ExpireChallenges removes the challenges nobody accepted in time and refunds their creators.
+// They iterate oldest first and stop at the first one still open, which only holds back later ones
+// when governance shortened the max challenge duration in between. They cannot be accepted past
+// their deadline meanwhile, so they are refunded at most one old duration late. To cap the gas of
+// a block, no more than MaxChallengeExpiriesPerBlock challenges expire, the others do in the next
+// block.
+func (k Keeper) ExpireChallenges(goCtx context.Context) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ChallengeKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	limit := int(k.MaxChallengeExpiriesPerBlock(ctx))
+	var expired []types.Challenge
+	for ; iterator.Valid() && len(expired) < limit; iterator.Next() {
+		var challenge types.Challenge
+		k.cdc.MustUnmarshal(iterator.Value(), &challenge)
+		deadline, err := challenge.GetDeadlineAsTime()
+		if err != nil {
+			panic(err)
+		}
+		if !deadline.Before(ctx.BlockTime()) {
+			break
+		}
+		expired = append(expired, challenge)
+	}
+	iterator.Close()
+
+	for _, challenge := range expired {
+		k.RemoveChallenge(ctx, challenge.Index)
+		k.MustRefundChallengeWager(ctx, &challenge)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.ChallengeExpiredEventType,
+				sdk.NewAttribute(types.ChallengeExpiredEventChallengeIndex, challenge.Index),
+			),
+		)
+	}
+}
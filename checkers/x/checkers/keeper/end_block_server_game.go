@@ -9,6 +9,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// ForfeitExpiredGames forfeits the games past their deadline, as found in the deadline index, so
+// that games with different time controls expire in time. To cap the gas of a block, no more than
+// MaxForfeitsPerBlock games are forfeited, the others are in the next block.
 func (k Keeper) ForfeitExpiredGames(goCtx context.Context) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -17,63 +20,51 @@ func (k Keeper) ForfeitExpiredGames(goCtx context.Context) {
 		rules.PieceStrings[rules.RED_PLAYER]:   rules.PieceStrings[rules.BLACK_PLAYER],
 	}
 
-	// Get FIFO information
-
+	// Tournament games that end here may start the next round, which takes new game indices.
 	systemInfo, found := k.GetSystemInfo(ctx)
 	if !found {
 		panic("SystemInfo not found")
 	}
 
-	gameIndex := systemInfo.FifoHeadIndex
-	var storedGame types.StoredGame
-	for {
-		// Finished moving along
-		if gameIndex == types.NoFifoIndex {
-			break
-		}
-		storedGame, found = k.GetStoredGame(ctx, gameIndex)
+	for _, gameIndex := range k.GetExpiredGameIndices(ctx, int(k.MaxForfeitsPerBlock(ctx))) {
+		storedGame, found := k.GetStoredGame(ctx, gameIndex)
 		if !found {
-			panic("Fifo head game not found " + systemInfo.FifoHeadIndex)
-		}
-		deadline, err := storedGame.GetDeadlineAsTime()
-		if err != nil {
-			panic(err)
+			panic("Expired game not found " + gameIndex)
 		}
-		if deadline.Before(ctx.BlockTime()) {
-			// Game is past deadline
-			k.RemoveFromFifo(ctx, &storedGame, &systemInfo)
-			lastBoard := storedGame.Board
-			if storedGame.MoveCount <= 1 {
-				// No point in keeping a game that was never really played.
-				k.RemoveStoredGame(ctx, gameIndex)
-				if storedGame.MoveCount == 1 {
-					// if there has only been one move then refund the person who did not forfeit.
-					k.MustRefundWager(ctx, &storedGame)
-				}
-			} else {
-				storedGame.Winner, found = opponents[storedGame.Turn]
-				if !found {
-					panic(fmt.Sprintf(types.ErrCannotFindWinnerByColor.Error(), storedGame.Turn))
-				}
-				// If the winner is found then pay out the winnings to them.
-				k.MustPayWinnings(ctx, &storedGame)
-				storedGame.Board = ""
-				k.SetStoredGame(ctx, storedGame)
-			}
-			ctx.EventManager().EmitEvent(
-				sdk.NewEvent(types.GameForfeitedEventType,
-					sdk.NewAttribute(types.GameForfeitedEventGameIndex, gameIndex),
-					sdk.NewAttribute(types.GameForfeitedEventWinner, storedGame.Winner),
-					sdk.NewAttribute(types.GameForfeitedEventBoard, lastBoard),
-				),
-			)
-			// Move along FIFO
-			gameIndex = systemInfo.FifoHeadIndex
+		if storedGame.MoveCount <= 1 && storedGame.TournamentIndex == "" {
+			// No point in keeping a game that was never really played, or never even accepted.
+			// Whatever is in escrow goes back to those who paid it. Tournament games are forfeited
+			// all the same, as the tournament waits for their result.
+			k.RemoveStoredGame(ctx, gameIndex)
+			k.RemoveGameMoves(ctx, gameIndex)
+			k.MustRefundWager(ctx, &storedGame)
 		} else {
-			// All other games are active anyway
-			break
+			storedGame.Winner, found = opponents[storedGame.Turn]
+			if !found {
+				panic(fmt.Sprintf(types.ErrCannotFindWinnerByColor.Error(), storedGame.Turn))
+			}
+			// If the winner is found then pay out the winnings to them.
+			k.MustPayWinnings(ctx, &storedGame)
+			k.MustRegisterGameResult(ctx, &storedGame, true)
+			// The game is forfeited all the same if its tournament cannot take the result.
+			k.runOrLog(ctx, "register tournament result of game "+gameIndex, func(ctx sdk.Context) error {
+				return k.RegisterTournamentResult(ctx, &storedGame, &systemInfo)
+			})
+			storedGame.Status = types.GameStatusFinished
+			storedGame.Forfeited = true
+			storedGame.PositionHistory = nil
+			storedGame.MovesWithoutProgress = 0
+			storedGame.CapturingSquare = 0
+			storedGame.CapturedSquares = nil
+			k.SetStoredGame(ctx, storedGame)
 		}
-
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.GameForfeitedEventType,
+				sdk.NewAttribute(types.GameForfeitedEventGameIndex, gameIndex),
+				sdk.NewAttribute(types.GameForfeitedEventWinner, storedGame.Winner),
+				sdk.NewAttribute(types.GameForfeitedEventBoard, storedGame.Board),
+			),
+		)
 	}
 	k.SetSystemInfo(ctx, systemInfo)
 }
@@ -4,15 +4,23 @@ import (
 	"testing"
 	"time"
 
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/checkers"
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/testutil"
 	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
 func TestForfeitUnplayed(t *testing.T) {
-	_, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	_, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
+	// Nobody has moved yet, so both get their wager back.
+	escrow.ExpectRefund(context, bob, 45).Times(1)
+	escrow.ExpectRefund(context, carol, 45).Times(1)
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
@@ -25,13 +33,11 @@ func TestForfeitUnplayed(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId: 2,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 3)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -43,14 +49,17 @@ func TestForfeitUnplayed(t *testing.T) {
 }
 
 func TestForfeitOlderUnplayed(t *testing.T) {
-	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
+	escrow.ExpectPay(context, carol, 46).Times(1)
+	escrow.ExpectRefund(context, bob, 45).Times(1)
+	escrow.ExpectRefund(context, carol, 45).Times(1)
 	msgServer.CreateGame(context, &types.MsgCreateGame{
-		Creator: bob,
+		Creator: carol,
 		Black:   carol,
 		Red:     alice,
-		Wager:   46,
+		Wager:   sdk.NewInt64Coin("stake", 46),
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -64,13 +73,11 @@ func TestForfeitOlderUnplayed(t *testing.T) {
 	nextGame, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "2",
-		FifoTailIndex: "2",
+		NextId: 3,
 	}, nextGame)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 3)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -82,20 +89,26 @@ func TestForfeitOlderUnplayed(t *testing.T) {
 }
 
 func TestForfeit2OldestUnplayedIn1Call(t *testing.T) {
-	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
+	escrow.ExpectPay(context, carol, 46).Times(1)
+	escrow.ExpectPay(context, alice, 47).Times(1)
+	escrow.ExpectRefund(context, bob, 45).Times(1)
+	escrow.ExpectRefund(context, carol, 45).Times(1)
+	// The second game was never accepted, only its creator gets a refund.
+	escrow.ExpectRefund(context, carol, 46).Times(1)
 	msgServer.CreateGame(context, &types.MsgCreateGame{
-		Creator: bob,
+		Creator: carol,
 		Black:   carol,
 		Red:     alice,
-		Wager:   46,
+		Wager:   sdk.NewInt64Coin("stake", 46),
 	})
 	msgServer.CreateGame(context, &types.MsgCreateGame{
-		Creator: carol,
+		Creator: alice,
 		Black:   alice,
 		Red:     bob,
-		Wager:   47,
+		Wager:   sdk.NewInt64Coin("stake", 47),
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -115,13 +128,11 @@ func TestForfeit2OldestUnplayedIn1Call(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "3",
-		FifoTailIndex: "3",
+		NextId: 4,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 3)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -139,8 +150,8 @@ func TestForfeitPlayedOnce(t *testing.T) {
 	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
-	pay := escrow.ExpectPay(context, bob, 45).Times(1)
-	escrow.ExpectRefund(context, bob, 45).Times(1).After(pay)
+	escrow.ExpectRefund(context, bob, 45).Times(1)
+	escrow.ExpectRefund(context, carol, 45).Times(1)
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -161,13 +172,11 @@ func TestForfeitPlayedOnce(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId: 2,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -182,8 +191,9 @@ func TestForfeitOlderPlayedOnce(t *testing.T) {
 	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
-	pay := escrow.ExpectPay(context, bob, 45).Times(1)
-	escrow.ExpectRefund(context, bob, 45).Times(1).After(pay)
+	escrow.ExpectPay(context, alice, 46).Times(1)
+	escrow.ExpectRefund(context, bob, 45).Times(1)
+	escrow.ExpectRefund(context, carol, 45).Times(1)
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -193,10 +203,10 @@ func TestForfeitOlderPlayedOnce(t *testing.T) {
 		ToY:       3,
 	})
 	msgServer.CreateGame(context, &types.MsgCreateGame{
-		Creator: bob,
+		Creator: alice,
 		Red:     carol,
 		Black:   alice,
-		Wager:   46,
+		Wager:   sdk.NewInt64Coin("stake", 46),
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -210,13 +220,11 @@ func TestForfeitOlderPlayedOnce(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "2",
-		FifoTailIndex: "2",
+		NextId: 3,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -231,10 +239,13 @@ func TestForfeit2OldestPlayedOnceIn1Call(t *testing.T) {
 	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
-	payBob := escrow.ExpectPay(context, bob, 45).Times(1)
-	payCarol := escrow.ExpectPay(context, carol, 46).Times(1).After(payBob)
-	refundBob := escrow.ExpectRefund(context, bob, 45).Times(1).After(payCarol)
-	escrow.ExpectRefund(context, carol, 46).Times(1).After(refundBob)
+	payCarol := escrow.ExpectPay(context, carol, 46).Times(1)
+	payAlice := escrow.ExpectPay(context, alice, 46).Times(1).After(payCarol)
+	escrow.ExpectPay(context, alice, 47).Times(1).After(payAlice)
+	escrow.ExpectRefund(context, bob, 45).Times(1)
+	escrow.ExpectRefund(context, carol, 45).Times(1)
+	escrow.ExpectRefund(context, carol, 46).Times(1)
+	escrow.ExpectRefund(context, alice, 46).Times(1)
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -244,10 +255,14 @@ func TestForfeit2OldestPlayedOnceIn1Call(t *testing.T) {
 		ToY:       3,
 	})
 	msgServer.CreateGame(context, &types.MsgCreateGame{
-		Creator: bob,
+		Creator: carol,
 		Black:   carol,
 		Red:     alice,
-		Wager:   46,
+		Wager:   sdk.NewInt64Coin("stake", 46),
+	})
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   alice,
+		GameIndex: "2",
 	})
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   carol,
@@ -258,10 +273,10 @@ func TestForfeit2OldestPlayedOnceIn1Call(t *testing.T) {
 		ToY:       3,
 	})
 	msgServer.CreateGame(context, &types.MsgCreateGame{
-		Creator: carol,
+		Creator: alice,
 		Black:   alice,
 		Red:     bob,
-		Wager:   47,
+		Wager:   sdk.NewInt64Coin("stake", 47),
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -281,13 +296,11 @@ func TestForfeit2OldestPlayedOnceIn1Call(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "3",
-		FifoTailIndex: "3",
+		NextId: 4,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[1]
 	require.EqualValues(t,
 		sdk.StringEvent{
 			Type: "game-forfeited",
@@ -306,9 +319,7 @@ func TestForfeitPlayedTwice(t *testing.T) {
 	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
-	payBob := escrow.ExpectPay(context, bob, 45).Times(1)
-	payCarol := escrow.ExpectPay(context, carol, 45).Times(1).After(payBob)
-	escrow.ExpectRefund(context, carol, 90).Times(1).After(payCarol)
+	escrow.ExpectRefund(context, carol, 90).Times(1)
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -336,28 +347,29 @@ func TestForfeitPlayedTwice(t *testing.T) {
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
 		Index:       "1",
-		Board:       "",
+		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
 		Turn:        "b",
 		Black:       bob,
 		Red:         carol,
 		MoveCount:   uint64(2),
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
 		Deadline:    oldDeadline,
+		TimeControl: types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
 		Winner:      "r",
 		Wager:       45,
+		Denom:       "stake",
+		Status:      types.GameStatusFinished,
+		Forfeited:   true,
+		Creator:     bob,
 	}, game1)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId: 2,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -372,9 +384,8 @@ func TestForfeitOlderPlayedTwice(t *testing.T) {
 	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
-	payBob := escrow.ExpectPay(context, bob, 45).Times(1)
-	payCarol := escrow.ExpectPay(context, carol, 45).Times(1).After(payBob)
-	escrow.ExpectRefund(context, carol, 90).Times(1).After(payCarol)
+	escrow.ExpectPay(context, carol, 46).Times(1)
+	escrow.ExpectRefund(context, carol, 90).Times(1)
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -392,10 +403,10 @@ func TestForfeitOlderPlayedTwice(t *testing.T) {
 		ToY:       4,
 	})
 	msgServer.CreateGame(context, &types.MsgCreateGame{
-		Creator: bob,
+		Creator: carol,
 		Black:   carol,
 		Red:     alice,
-		Wager:   46,
+		Wager:   sdk.NewInt64Coin("stake", 46),
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -408,28 +419,29 @@ func TestForfeitOlderPlayedTwice(t *testing.T) {
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
 		Index:       "1",
-		Board:       "",
+		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
 		Turn:        "b",
 		Black:       bob,
 		Red:         carol,
 		MoveCount:   uint64(2),
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
 		Deadline:    oldDeadline,
+		TimeControl: types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
 		Winner:      "r",
 		Wager:       45,
+		Denom:       "stake",
+		Status:      types.GameStatusFinished,
+		Forfeited:   true,
+		Creator:     bob,
 	}, game1)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "2",
-		FifoTailIndex: "2",
+		NextId: 3,
 	}, systemInfo)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -444,11 +456,10 @@ func TestForfeit2OldestPlayedTwiceIn1Call(t *testing.T) {
 	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
-	payBob := escrow.ExpectPay(context, bob, 45).Times(1)
-	payCarol1 := escrow.ExpectPay(context, carol, 45).Times(1).After(payBob)
-	payCarol2 := escrow.ExpectPay(context, carol, 46).Times(1).After(payCarol1)
-	payAlice := escrow.ExpectPay(context, alice, 46).Times(1).After(payCarol2)
-	refundCarol := escrow.ExpectRefund(context, carol, 90).Times(1).After(payAlice)
+	payCarol := escrow.ExpectPay(context, carol, 46).Times(1)
+	payAlice := escrow.ExpectPay(context, alice, 46).Times(1).After(payCarol)
+	escrow.ExpectPay(context, alice, 47).Times(1).After(payAlice)
+	refundCarol := escrow.ExpectRefund(context, carol, 90).Times(1)
 	escrow.ExpectRefund(context, alice, 92).Times(1).After(refundCarol)
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
@@ -467,10 +478,14 @@ func TestForfeit2OldestPlayedTwiceIn1Call(t *testing.T) {
 		ToY:       4,
 	})
 	msgServer.CreateGame(context, &types.MsgCreateGame{
-		Creator: bob,
+		Creator: carol,
 		Black:   carol,
 		Red:     alice,
-		Wager:   46,
+		Wager:   sdk.NewInt64Coin("stake", 46),
+	})
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   alice,
+		GameIndex: "2",
 	})
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   carol,
@@ -489,10 +504,10 @@ func TestForfeit2OldestPlayedTwiceIn1Call(t *testing.T) {
 		ToY:       4,
 	})
 	msgServer.CreateGame(context, &types.MsgCreateGame{
-		Creator: carol,
+		Creator: alice,
 		Black:   alice,
 		Red:     bob,
-		Wager:   47,
+		Wager:   sdk.NewInt64Coin("stake", 47),
 	})
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -509,45 +524,49 @@ func TestForfeit2OldestPlayedTwiceIn1Call(t *testing.T) {
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
 		Index:       "1",
-		Board:       "",
+		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
 		Turn:        "b",
 		Black:       bob,
 		Red:         carol,
 		MoveCount:   uint64(2),
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
 		Deadline:    oldDeadline,
+		TimeControl: types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
 		Winner:      "r",
 		Wager:       45,
+		Denom:       "stake",
+		Status:      types.GameStatusFinished,
+		Forfeited:   true,
+		Creator:     bob,
 	}, game1)
 
 	game2, found = keeper.GetStoredGame(ctx, "2")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
 		Index:       "2",
-		Board:       "",
+		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
 		Turn:        "b",
 		Black:       carol,
 		Red:         alice,
 		MoveCount:   uint64(2),
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
 		Deadline:    oldDeadline,
+		TimeControl: types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
 		Winner:      "r",
 		Wager:       46,
+		Denom:       "stake",
+		Status:      types.GameStatusFinished,
+		Forfeited:   true,
+		Creator:     carol,
 	}, game2)
 
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "3",
-		FifoTailIndex: "3",
+		NextId: 4,
 	}, systemInfo)
 
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-forfeited",
 		Attributes: []sdk.Attribute{
@@ -560,3 +579,156 @@ func TestForfeit2OldestPlayedTwiceIn1Call(t *testing.T) {
 		},
 	}, event)
 }
+
+func TestForfeitUnaccepted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	escrow := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, escrow)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	msgServer := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	pay := escrow.ExpectPay(context, bob, 45).Times(1)
+	// Carol never accepted, so only bob's wager is in escrow.
+	escrow.ExpectRefund(context, bob, 45).Times(1).After(pay)
+	msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+	})
+	game1, found := k.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	k.SetStoredGame(ctx, game1)
+	k.ForfeitExpiredGames(context)
+
+	_, found = k.GetStoredGame(ctx, "1")
+	require.False(t, found)
+}
+
+func TestForfeitShorterGameCreatedLater(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	escrow := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, escrow)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	msgServer := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	escrow.ExpectPay(context, bob, 45).Times(1)
+	escrow.ExpectPay(context, alice, 46).Times(1)
+	msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+	})
+	msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator:     alice,
+		Black:       alice,
+		Red:         carol,
+		Wager:       sdk.NewInt64Coin("stake", 46),
+		TimeControl: types.TimeControl{TurnDuration: 30 * time.Second},
+	})
+	// The second game expires first even though it was created last.
+	later := ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	laterContext := sdk.WrapSDKContext(later)
+	escrow.ExpectRefund(laterContext, alice, 46).Times(1)
+	k.ForfeitExpiredGames(laterContext)
+
+	_, found := k.GetStoredGame(later, "2")
+	require.False(t, found)
+	_, found = k.GetStoredGame(later, "1")
+	require.True(t, found)
+}
+
+func TestForfeitNoMoreThanMaxPerBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	escrow := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, escrow)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	msgServer := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	escrow.ExpectAny(context)
+	for i := 0; i <= int(types.DefaultMaxForfeitsPerBlock); i++ {
+		msgServer.CreateGame(context, &types.MsgCreateGame{
+			Creator: bob,
+			Black:   bob,
+			Red:     carol,
+			Wager:   sdk.NewInt64Coin("stake", 45),
+		})
+	}
+	later := sdk.WrapSDKContext(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)))
+	escrow.ExpectRefund(later, bob, 45).Times(int(types.DefaultMaxForfeitsPerBlock))
+	k.ForfeitExpiredGames(later)
+	require.Len(t, k.GetAllStoredGame(ctx), 1)
+
+	evenLater := sdk.WrapSDKContext(ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour)))
+	escrow.ExpectRefund(evenLater, bob, 45).Times(1)
+	k.ForfeitExpiredGames(evenLater)
+	require.Len(t, k.GetAllStoredGame(ctx), 0)
+}
+
+func TestForfeitMaxPerBlockFromParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	escrow := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, escrow)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	params := k.GetParams(ctx)
+	params.MaxForfeitsPerBlock = 2
+	k.SetParams(ctx, params)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	escrow.ExpectAny(context)
+	for i := 0; i < 3; i++ {
+		msgServer.CreateGame(context, &types.MsgCreateGame{
+			Creator: bob,
+			Black:   bob,
+			Red:     carol,
+			Wager:   sdk.NewInt64Coin("stake", 45),
+		})
+	}
+	later := sdk.WrapSDKContext(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)))
+	escrow.ExpectRefund(later, bob, 45).Times(2)
+	k.ForfeitExpiredGames(later)
+	require.Len(t, k.GetAllStoredGame(ctx), 1)
+}
+
+func TestForfeitPlayedTwiceRegistersResult(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectRefund(context, carol, 90).Times(1)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	game1, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	game1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.SetStoredGame(ctx, game1)
+	keeper.ForfeitExpiredGames(context)
+
+	bobInfo, found := keeper.GetPlayerInfo(ctx, bob)
+	require.True(t, found)
+	require.EqualValues(t, 1, bobInfo.ForfeitedCount)
+	require.EqualValues(t, 1184, bobInfo.EloRating)
+	carolInfo, found := keeper.GetPlayerInfo(ctx, carol)
+	require.True(t, found)
+	require.EqualValues(t, 1, carolInfo.WonCount)
+	require.EqualValues(t, 1216, carolInfo.EloRating)
+}
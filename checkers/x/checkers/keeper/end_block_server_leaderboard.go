@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetLeaderboardCandidate marks the player to be put on the leaderboard as they are, at the next
+// refresh.
+func (k Keeper) SetLeaderboardCandidate(ctx sdk.Context, address string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LeaderboardCandidateKeyPrefix))
+	store.Set([]byte(address), []byte{})
+}
+
+// GetAllLeaderboardCandidate returns the addresses of the players waiting for the next refresh
+func (k Keeper) GetAllLeaderboardCandidate(ctx sdk.Context) (list []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LeaderboardCandidateKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, string(iterator.Key()))
+	}
+
+	return
+}
+
+// RefreshLeaderboard rebuilds the leaderboard from the best rated players when some records changed
+// since its last refresh. It only does so every LeaderboardRefreshInterval blocks, so that a block
+// pays for the players of a whole interval at once instead of for each result.
+func (k Keeper) RefreshLeaderboard(goCtx context.Context) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if uint64(ctx.BlockHeight())%k.LeaderboardRefreshInterval(ctx) != 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.LeaderboardCandidateKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	changed := map[string]bool{}
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		changed[string(iterator.Key())] = true
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	if len(keys) == 0 {
+		return
+	}
+
+	leaderboard, found := k.GetLeaderboard(ctx)
+	if !found {
+		panic("Leaderboard not found")
+	}
+	// Players who dropped off the leaderboard come back on it as soon as they are among the best again.
+	length := k.LeaderboardLength(ctx)
+	leaderboard.UpdatePlayers(k.GetBestPlayerInfos(ctx, length), changed, ctx.BlockTime(), length)
+	k.SetLeaderboard(ctx, leaderboard)
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
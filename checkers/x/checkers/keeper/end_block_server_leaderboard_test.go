@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/checkers"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshLeaderboardOnInterval(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	checkers.InitGenesis(ctx, *keeper, *types.DefaultGenesis())
+	keeper.MustRegisterGameResult(ctx, &types.StoredGame{Black: bob, Red: carol, Winner: "b"}, false)
+
+	keeper.RefreshLeaderboard(sdk.WrapSDKContext(ctx.WithBlockHeight(99)))
+	leaderboard, found := keeper.GetLeaderboard(ctx)
+	require.True(t, found)
+	require.Empty(t, leaderboard.Winners)
+
+	refreshCtx := ctx.WithBlockHeight(100)
+	keeper.RefreshLeaderboard(sdk.WrapSDKContext(refreshCtx))
+	leaderboard, found = keeper.GetLeaderboard(ctx)
+	require.True(t, found)
+	require.Equal(t, []types.WinningPlayer{
+		{PlayerAddress: bob, EloRating: 1216, WonCount: 1, DateAdded: types.FormatDeadline(refreshCtx.BlockTime())},
+		{PlayerAddress: carol, EloRating: 1184, WonCount: 0, DateAdded: types.FormatDeadline(refreshCtx.BlockTime())},
+	}, leaderboard.Winners)
+}
+
+func TestRefreshLeaderboardOnlyCandidatesOnce(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	checkers.InitGenesis(ctx, *keeper, *types.DefaultGenesis())
+	keeper.MustRegisterGameResult(ctx, &types.StoredGame{Black: bob, Red: carol, Winner: "b"}, false)
+	keeper.RefreshLeaderboard(sdk.WrapSDKContext(ctx.WithBlockHeight(100)))
+
+	// A record changed without going through a game result is not picked up.
+	bobInfo, _ := keeper.GetPlayerInfo(ctx, bob)
+	bobInfo.EloRating = 1000
+	keeper.SetPlayerInfo(ctx, bobInfo)
+	keeper.RefreshLeaderboard(sdk.WrapSDKContext(ctx.WithBlockHeight(200)))
+	leaderboard, _ := keeper.GetLeaderboard(ctx)
+	require.EqualValues(t, 1216, leaderboard.Winners[0].EloRating)
+}
+
+func TestRefreshLeaderboardKeepsLengthParam(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	genesis := types.DefaultGenesis()
+	genesis.Params.LeaderboardLength = 1
+	checkers.InitGenesis(ctx, *keeper, *genesis)
+	keeper.MustRegisterGameResult(ctx, &types.StoredGame{Black: bob, Red: carol, Winner: "r"}, false)
+	keeper.RefreshLeaderboard(sdk.WrapSDKContext(ctx.WithBlockHeight(100)))
+	leaderboard, _ := keeper.GetLeaderboard(ctx)
+	require.Len(t, leaderboard.Winners, 1)
+	require.Equal(t, carol, leaderboard.Winners[0].PlayerAddress)
+}
+
+func TestRefreshLeaderboardBringsBackPlayersCutBefore(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	genesis := types.DefaultGenesis()
+	genesis.Params.LeaderboardLength = 2
+	checkers.InitGenesis(ctx, *keeper, *genesis)
+	keeper.MustRegisterGameResult(ctx, &types.StoredGame{Black: bob, Red: carol, Winner: "b"}, false)
+	keeper.MustRegisterGameResult(ctx, &types.StoredGame{Black: alice, Red: carol, Winner: "b"}, false)
+	keeper.RefreshLeaderboard(sdk.WrapSDKContext(ctx.WithBlockHeight(100)))
+	leaderboard, _ := keeper.GetLeaderboard(ctx)
+	require.Equal(t, bob, leaderboard.Winners[0].PlayerAddress)
+	require.Equal(t, alice, leaderboard.Winners[1].PlayerAddress)
+
+	// Bob drops below carol, who has not played since she was cut.
+	for i := 0; i < 4; i++ {
+		keeper.MustRegisterGameResult(ctx, &types.StoredGame{Black: alice, Red: bob, Winner: "b"}, false)
+	}
+	refreshCtx := ctx.WithBlockHeight(200)
+	keeper.RefreshLeaderboard(sdk.WrapSDKContext(refreshCtx))
+	leaderboard, _ = keeper.GetLeaderboard(ctx)
+	carolInfo, _ := keeper.GetPlayerInfo(ctx, carol)
+	bobInfo, _ := keeper.GetPlayerInfo(ctx, bob)
+	require.Less(t, bobInfo.EloRating, carolInfo.EloRating)
+	require.Len(t, leaderboard.Winners, 2)
+	require.Equal(t, alice, leaderboard.Winners[0].PlayerAddress)
+	require.Equal(t, types.WinningPlayer{
+		PlayerAddress: carol,
+		EloRating:     carolInfo.EloRating,
+		DateAdded:     types.FormatDeadline(refreshCtx.BlockTime()),
+	}, leaderboard.Winners[1])
+}
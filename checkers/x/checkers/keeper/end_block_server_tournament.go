@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// StartTournaments pairs the first round of the open tournaments past their start time. Those that
+// fewer than two players joined are cancelled and their entry fees refunded. A tournament that
+// cannot be started is left open, and tried again at the next block.
+func (k Keeper) StartTournaments(goCtx context.Context) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	systemInfo, found := k.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+
+	for _, tournamentIndex := range k.GetTournamentIndicesToStart(ctx) {
+		k.runOrLog(ctx, "start tournament "+tournamentIndex, func(ctx sdk.Context) error {
+			return k.startTournament(ctx, tournamentIndex, &systemInfo)
+		})
+	}
+	k.SetSystemInfo(ctx, systemInfo)
+}
+
+func (k Keeper) startTournament(ctx sdk.Context, tournamentIndex string, systemInfo *types.SystemInfo) error {
+	tournament, found := k.GetTournament(ctx, tournamentIndex)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrTournamentNotFound, "%s", tournamentIndex)
+	}
+	if len(tournament.Players) < 2 {
+		tournament.Status = types.TournamentStatusCancelled
+		if err := k.RefundEntryFees(ctx, &tournament); err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.TournamentCancelledEventType,
+				sdk.NewAttribute(types.TournamentCancelledEventTournamentIndex, tournamentIndex),
+			),
+		)
+	} else {
+		tournament.Status = types.TournamentStatusRunning
+		if err := k.startNextRound(ctx, &tournament, systemInfo); err != nil {
+			return err
+		}
+	}
+	k.SetTournament(ctx, tournament)
+	return nil
+}
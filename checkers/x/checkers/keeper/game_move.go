@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AppendGameMove adds a gameMove to its game's move log
+func (k Keeper) AppendGameMove(ctx sdk.Context, gameMove types.GameMove) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GameMovesKeyPrefix(gameMove.GameIndex))
+	b := k.cdc.MustMarshal(&gameMove)
+	store.Set(types.GameMoveKey(
+		gameMove.MoveIndex,
+	), b)
+}
+
+// GetAllGameMove returns all the moves of a game, in the order they were played
+func (k Keeper) GetAllGameMove(ctx sdk.Context, gameIndex string) (list []types.GameMove) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GameMovesKeyPrefix(gameIndex))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.GameMove
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// HasPlayed tells whether the player has moved in the game, reading the move log only up to
+// their first move
+func (k Keeper) HasPlayed(ctx sdk.Context, gameIndex string, player string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GameMovesKeyPrefix(gameIndex))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.GameMove
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		if val.Player == player {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RemoveGameMoves removes the move log of a game from the store
+func (k Keeper) RemoveGameMoves(ctx sdk.Context, gameIndex string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GameMovesKeyPrefix(gameIndex))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// RemoveGameMovesFrom removes the moves of a game from the given move index on, as when they are
+// taken back
+func (k Keeper) RemoveGameMovesFrom(ctx sdk.Context, gameIndex string, moveIndex uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GameMovesKeyPrefix(gameIndex))
+	iterator := store.Iterator(types.GameMoveKey(moveIndex), nil)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// gameMovesAlong replays the path hop by hop on a copy of the game as it was before the move,
+// so that each hop is logged with its own capture and promotion.
+func gameMovesAlong(ctx sdk.Context, storedGame types.StoredGame, path []rules.Pos) ([]types.GameMove, error) {
+	replay, err := storedGame.ParseGame()
+	if err != nil {
+		return nil, err
+	}
+	player := rules.PieceStrings[replay.Turn]
+	blockTime := ctx.BlockTime().UTC().Format(types.DeadlineLayout)
+	gameMoves := make([]types.GameMove, 0, len(path)-1)
+	for i := 1; i < len(path); i++ {
+		src, dst := path[i-1], path[i]
+		wasKing := replay.Pieces[src].King
+		captured, err := replay.Move(src, dst)
+		if err != nil {
+			return nil, err
+		}
+		gameMoves = append(gameMoves, types.GameMove{
+			GameIndex:   storedGame.Index,
+			MoveIndex:   storedGame.MoveCount + uint64(i-1),
+			Player:      player,
+			FromX:       uint64(src.X),
+			FromY:       uint64(src.Y),
+			ToX:         uint64(dst.X),
+			ToY:         uint64(dst.Y),
+			CapturedX:   int32(captured.X),
+			CapturedY:   int32(captured.Y),
+			Promoted:    !wasKing && replay.Pieces[dst].King,
+			BlockHeight: ctx.BlockHeight(),
+			BlockTime:   blockTime,
+		})
+	}
+	return gameMoves, nil
+}
@@ -41,6 +41,14 @@ func (k Keeper) CanPlayMove(goCtx context.Context, req *types.QueryCanPlayMoveRe
 		}, nil
 	}
 
+	// Is the game still waiting for the opponent to accept it?
+	if storedGame.Status == types.GameStatusPending {
+		return &types.QueryCanPlayMoveResponse{
+			Possible: false,
+			Reason:   types.ErrGameNotAccepted.Error(),
+		}, nil
+	}
+
 	// Is the player actually one of the game players?
 
 	isBlack := rules.PieceStrings[rules.BLACK_PLAYER] == req.Player
@@ -54,8 +62,9 @@ func (k Keeper) CanPlayMove(goCtx context.Context, req *types.QueryCanPlayMoveRe
 		player = rules.RED_PLAYER
 	} else {
 		return &types.QueryCanPlayMoveResponse{
-			Possible: false,
-			Reason:   fmt.Sprintf("%s: %s", types.ErrCreatorNotPlayer.Error(), req.Player),
+			Possible:  false,
+			Reason:    fmt.Sprintf("%s: %s", types.ErrCreatorNotPlayer.Error(), req.Player),
+			DrawOffer: storedGame.DrawOffer,
 		}, nil
 	}
 
@@ -67,8 +76,9 @@ func (k Keeper) CanPlayMove(goCtx context.Context, req *types.QueryCanPlayMoveRe
 	}
 	if !game.TurnIs(player) {
 		return &types.QueryCanPlayMoveResponse{
-			Possible: false,
-			Reason:   fmt.Sprintf("%s: %s", types.ErrNotPlayerTurn.Error(), player.Color),
+			Possible:  false,
+			Reason:    fmt.Sprintf("%s: %s", types.ErrNotPlayerTurn.Error(), player.Color),
+			DrawOffer: storedGame.DrawOffer,
 		}, nil
 	}
 
@@ -80,20 +90,23 @@ func (k Keeper) CanPlayMove(goCtx context.Context, req *types.QueryCanPlayMoveRe
 			Y: int(req.FromY),
 		},
 		rules.Pos{
-			X: int(req.FromX),
-			Y: int(req.FromY),
+			X: int(req.ToX),
+			Y: int(req.ToY),
 		},
 	)
 	if moveErr != nil {
 		return &types.QueryCanPlayMoveResponse{
-			Possible: false,
-			Reason:   fmt.Sprintf("%s: %s", types.ErrWrongMove.Error(), moveErr.Error()),
+			Possible:  false,
+			Reason:    fmt.Sprintf("%s: %s", types.ErrWrongMove.Error(), moveErr.Error()),
+			DrawOffer: storedGame.DrawOffer,
 		}, nil
 	}
 
+	// A pending draw offer does not prevent playing, but the player should know about it.
 	return &types.QueryCanPlayMoveResponse{
-		Possible: true,
-		Reason:   "ok",
+		Possible:  true,
+		Reason:    "ok",
+		DrawOffer: storedGame.DrawOffer,
 	}, nil
 
 	//return &types.QueryCanPlayMoveResponse{}, nil
@@ -78,7 +78,7 @@ var (
 				ToY:       3,
 			},
 			response: nil,
-			err:      "2: game id not found",
+			err:      "2: game by id not found",
 		},
 		{
 			desc: "Game finished, wrong",
@@ -139,7 +139,7 @@ var (
 			},
 			response: &types.QueryCanPlayMoveResponse{
 				Possible: false,
-				Reason:   "message creator is not player: u",
+				Reason:   "message creator is not a player: u",
 			},
 			err: "nil",
 		},
@@ -161,7 +161,7 @@ var (
 			},
 			response: &types.QueryCanPlayMoveResponse{
 				Possible: false,
-				Reason:   "player tried to play our of turn: red",
+				Reason:   "player tried to play out of turn: red",
 			},
 			err: "nil",
 		},
@@ -244,6 +244,29 @@ var (
 			response: canPlayOkResponse,
 			err:      "nil",
 		},
+		{
+			desc: "Game not accepted yet",
+			game: types.StoredGame{
+				Index:  "1",
+				Board:  "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+				Turn:   "b",
+				Winner: "*",
+				Status: types.GameStatusPending,
+			},
+			request: &types.QueryCanPlayMoveRequest{
+				GameIndex: "1",
+				Player:    "b",
+				FromX:     1,
+				FromY:     2,
+				ToX:       2,
+				ToY:       3,
+			},
+			response: &types.QueryCanPlayMoveResponse{
+				Possible: false,
+				Reason:   "game has not been accepted yet",
+			},
+			err: "nil",
+		},
 	}
 )
 
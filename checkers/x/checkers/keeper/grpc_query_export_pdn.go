@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The PDN date layout, and its value when the date is unknown.
+const (
+	pdnDateLayout  = "2006.01.02"
+	pdnUnknownDate = "????.??.??"
+)
+
+func (k Keeper) ExportPdn(goCtx context.Context, req *types.QueryExportPdnRequest) (*types.QueryExportPdnResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.GetStoredGame(ctx, req.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", req.GameIndex)
+	}
+
+	pdn, err := toPdn(ctx.ChainID(), storedGame, k.GetAllGameMove(ctx, req.GameIndex))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExportPdnResponse{
+		Pdn: pdn.String(),
+	}, nil
+}
+
+// toPdn gathers the hops of the move log into PDN moves, a new move starting each time
+// the player changes, and describes the game in the headers.
+func toPdn(chainId string, storedGame types.StoredGame, gameMoves []types.GameMove) (*rules.Pdn, error) {
+	variant, err := storedGame.ParseVariant()
+	if err != nil {
+		return nil, err
+	}
+
+	var moves []rules.PdnMove
+	for i, gameMove := range gameMoves {
+		to := rules.Pos{X: int(gameMove.ToX), Y: int(gameMove.ToY)}
+		if i == 0 || gameMoves[i-1].Player != gameMove.Player {
+			from := rules.Pos{X: int(gameMove.FromX), Y: int(gameMove.FromY)}
+			moves = append(moves, rules.PdnMove{Path: []rules.Pos{from}})
+		}
+		move := &moves[len(moves)-1]
+		move.Path = append(move.Path, to)
+		move.Capture = move.Capture || gameMove.CapturedX != int32(rules.NO_POS.X)
+	}
+
+	date := pdnUnknownDate
+	if 0 < len(gameMoves) {
+		if blockTime, err := time.Parse(types.DeadlineLayout, gameMoves[0].BlockTime); err == nil {
+			date = blockTime.Format(pdnDateLayout)
+		}
+	}
+
+	// The draw marker is not a piece, so it is not found among the pieces.
+	winner := rules.StringPieces[storedGame.Winner].Player
+	if storedGame.Winner == rules.PieceStrings[rules.DRAW_PLAYER] {
+		winner = rules.DRAW_PLAYER
+	}
+	result := variant.PdnResult(winner)
+
+	headers := []rules.PdnHeader{
+		{Name: "Event", Value: fmt.Sprintf("Game %s", storedGame.Index)},
+		{Name: "Site", Value: chainId},
+		{Name: "Date", Value: date},
+		{Name: rules.PDN_BLACK, Value: storedGame.Black},
+		{Name: rules.PDN_WHITE, Value: storedGame.Red},
+		{Name: rules.PDN_RESULT, Value: result},
+		{Name: rules.PDN_GAME_TYPE, Value: fmt.Sprint(variant.PdnGameType)},
+	}
+	if storedGame.Fen != "" {
+		headers = append(headers,
+			rules.PdnHeader{Name: rules.PDN_SET_UP, Value: "1"},
+			rules.PdnHeader{Name: rules.PDN_FEN, Value: storedGame.Fen},
+		)
+	}
+
+	return &rules.Pdn{
+		Headers: headers,
+		Variant: variant,
+		Moves:   moves,
+		Result:  result,
+	}, nil
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/testutil"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportPdnNoMoves(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	ctx := sdk.UnwrapSDKContext(context)
+
+	response, err := keeper.ExportPdn(context, &types.QueryExportPdnRequest{GameIndex: "1"})
+	require.Nil(t, err)
+	require.Equal(t, `[Event "Game 1"]
+[Site "`+ctx.ChainID()+`"]
+[Date "????.??.??"]
+[Black "`+bob+`"]
+[White "`+carol+`"]
+[Result "*"]
+[GameType "21"]
+
+*
+`, response.Pdn)
+}
+
+func TestExportPdnGameNotFound(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+
+	response, err := keeper.ExportPdn(context, &types.QueryExportPdnRequest{GameIndex: "2"})
+	require.Nil(t, response)
+	require.Equal(t, "2: game by id not found", err.Error())
+}
+
+func TestExportPdnAfterTwoMoves(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+
+	response, err := keeper.ExportPdn(context, &types.QueryExportPdnRequest{GameIndex: "1"})
+	require.Nil(t, err)
+	require.Contains(t, response.Pdn, "[Date \"0001.01.01\"]\n")
+	require.True(t, strings.HasSuffix(response.Pdn, "\n\n1. 9-14 21-17 *\n"))
+}
+
+func TestExportPdnDoubleJumpIsOneMove(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithDoubleJumpGame(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   bob,
+		GameIndex: "1",
+		Path:      []types.Position{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 6}},
+	})
+
+	response, err := keeper.ExportPdn(context, &types.QueryExportPdnRequest{GameIndex: "1"})
+	require.Nil(t, err)
+	require.True(t, strings.HasSuffix(response.Pdn, "\n\n1. 9x18x27 *\n"))
+}
+
+func TestExportPdnFinishedGameReplays(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+	testutil.PlayAllMoves(t, msgServer, context, "1", testutil.Game1Moves)
+
+	response, err := keeper.ExportPdn(context, &types.QueryExportPdnRequest{GameIndex: "1"})
+	require.Nil(t, err)
+	// The black king captures twice in a row over two transactions.
+	require.Contains(t, response.Pdn, " 30x23x14 ")
+	require.Contains(t, response.Pdn, "[Result \"1-0\"]\n")
+	require.True(t, strings.HasSuffix(response.Pdn, " 1-0\n"))
+
+	storedGame, _ := keeper.GetStoredGame(ctx, "1")
+	pdn, err := rules.ParsePdn(response.Pdn)
+	require.Nil(t, err)
+	black, _ := pdn.Header(rules.PDN_BLACK)
+	require.Equal(t, bob, black)
+	require.Equal(t, rules.PDN_FIRST_WINS, pdn.Result)
+	require.Len(t, pdn.Moves, len(testutil.Game1Moves)-1)
+	game, err := pdn.Replay()
+	require.Nil(t, err)
+	require.Equal(t, storedGame.Board, game.String())
+	require.Equal(t, rules.BLACK_PLAYER, game.Winner())
+}
+
+func TestParsePdnShortCaptureReplays(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+	testutil.PlayAllMoves(t, msgServer, context, "1", testutil.Game1Moves)
+	response, _ := keeper.ExportPdn(context, &types.QueryExportPdnRequest{GameIndex: "1"})
+
+	pdn, err := rules.ParsePdn(strings.Replace(response.Pdn, "30x23x14", "{only the ends} 30x14", 1))
+	require.Nil(t, err)
+	game, err := pdn.Replay()
+	require.Nil(t, err)
+	storedGame, _ := keeper.GetStoredGame(ctx, "1")
+	require.Equal(t, storedGame.Board, game.String())
+}
+
+func TestParsePdnWrongMove(t *testing.T) {
+	pdn, err := rules.ParsePdn("[GameType \"21\"]\n\n1. 9-14 14-18 *\n")
+	require.Nil(t, err)
+	game, err := pdn.Replay()
+	require.Nil(t, game)
+	require.Equal(t, "Move 2 (14-18): Not {black}'s turn", err.Error())
+}
+
+func TestExportPdnFromFenRedFirst(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+		Fen:     "W:W21,K30:B5,9",
+	})
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "2",
+	})
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "2",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	require.Nil(t, err)
+
+	response, err := keeper.ExportPdn(context, &types.QueryExportPdnRequest{GameIndex: "2"})
+	require.Nil(t, err)
+	require.Contains(t, response.Pdn, "[SetUp \"1\"]\n[FEN \"W:W21,K30:B5,9\"]\n")
+	require.True(t, strings.HasSuffix(response.Pdn, "\n\n1... 21-17 *\n"))
+
+	pdn, err := rules.ParsePdn(response.Pdn)
+	require.Nil(t, err)
+	game, err := pdn.Replay()
+	require.Nil(t, err)
+	storedGame, _ := keeper.GetStoredGame(ctx, "2")
+	require.Equal(t, storedGame.Board, game.String())
+	require.Equal(t, rules.BLACK_PLAYER, game.Turn)
+}
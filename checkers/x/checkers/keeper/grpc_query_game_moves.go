@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GameMoves(goCtx context.Context, req *types.QueryGameMovesRequest) (*types.QueryGameMovesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetStoredGame(ctx, req.GameIndex); !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", req.GameIndex)
+	}
+
+	var gameMoves []types.GameMove
+	gameMoveStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GameMovesKeyPrefix(req.GameIndex))
+
+	pageRes, err := query.Paginate(gameMoveStore, req.Pagination, func(key []byte, value []byte) error {
+		var gameMove types.GameMove
+		if err := k.cdc.Unmarshal(value, &gameMove); err != nil {
+			return err
+		}
+
+		gameMoves = append(gameMoves, gameMove)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGameMovesResponse{Moves: gameMoves, Pagination: pageRes}, nil
+}
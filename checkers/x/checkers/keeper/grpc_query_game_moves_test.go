@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGameMovesEmptyBeforeAnyMove(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+
+	response, err := keeper.GameMoves(context, &types.QueryGameMovesRequest{GameIndex: "1"})
+	require.Nil(t, err)
+	require.Empty(t, response.Moves)
+}
+
+func TestGameMovesGameNotFound(t *testing.T) {
+	_, keeper, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+
+	response, err := keeper.GameMoves(context, &types.QueryGameMovesRequest{GameIndex: "2"})
+	require.Nil(t, response)
+	require.Equal(t, "2: game by id not found", err.Error())
+}
+
+func TestGameMovesAfterTwoMoves(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+
+	response, err := keeper.GameMoves(context, &types.QueryGameMovesRequest{GameIndex: "1"})
+	require.Nil(t, err)
+	blockTime := types.FormatDeadline(ctx.BlockTime())
+	require.EqualValues(t, []types.GameMove{
+		{GameIndex: "1", MoveIndex: 0, Player: "b", FromX: 1, FromY: 2, ToX: 2, ToY: 3, CapturedX: -1, CapturedY: -1, BlockHeight: ctx.BlockHeight(), BlockTime: blockTime},
+		{GameIndex: "1", MoveIndex: 1, Player: "r", FromX: 0, FromY: 5, ToX: 1, ToY: 4, CapturedX: -1, CapturedY: -1, BlockHeight: ctx.BlockHeight(), BlockTime: blockTime},
+	}, response.Moves)
+	require.EqualValues(t, 2, response.Pagination.Total)
+}
+
+func TestGameMovesPaginated(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+
+	first, err := keeper.GameMoves(context, &types.QueryGameMovesRequest{
+		GameIndex:  "1",
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.Nil(t, err)
+	require.Len(t, first.Moves, 1)
+	require.EqualValues(t, 0, first.Moves[0].MoveIndex)
+	require.NotNil(t, first.Pagination.NextKey)
+
+	second, err := keeper.GameMoves(context, &types.QueryGameMovesRequest{
+		GameIndex:  "1",
+		Pagination: &query.PageRequest{Key: first.Pagination.NextKey, Limit: 1},
+	})
+	require.Nil(t, err)
+	require.Len(t, second.Moves, 1)
+	require.EqualValues(t, 1, second.Moves[0].MoveIndex)
+	require.Nil(t, second.Pagination.NextKey)
+}
+
+func TestGameMovesDoubleJumpLogsEachHop(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithDoubleJumpGame(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   bob,
+		GameIndex: "1",
+		Path:      []types.Position{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 6}},
+	})
+
+	response, err := keeper.GameMoves(context, &types.QueryGameMovesRequest{GameIndex: "1"})
+	require.Nil(t, err)
+	require.Len(t, response.Moves, 2)
+	require.EqualValues(t, 2, response.Moves[0].MoveIndex)
+	require.EqualValues(t, []int32{2, 3}, []int32{response.Moves[0].CapturedX, response.Moves[0].CapturedY})
+	require.EqualValues(t, 3, response.Moves[1].MoveIndex)
+	require.EqualValues(t, []int32{4, 5}, []int32{response.Moves[1].CapturedX, response.Moves[1].CapturedY})
+}
+
+func TestGameMovesPromotion(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, _ := keeper.GetStoredGame(ctx, "1")
+	storedGame.Board = "********|******r*|********|********|********|********|*b******|********"
+	keeper.SetStoredGame(ctx, storedGame)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     6,
+		ToX:       2,
+		ToY:       7,
+	})
+
+	response, err := keeper.GameMoves(context, &types.QueryGameMovesRequest{GameIndex: "1"})
+	require.Nil(t, err)
+	require.Len(t, response.Moves, 1)
+	require.True(t, response.Moves[0].Promoted)
+}
+
+func TestGameMovesRemovedWithRejectedGame(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+
+	require.Empty(t, keeper.GetAllGameMove(ctx, "1"))
+}
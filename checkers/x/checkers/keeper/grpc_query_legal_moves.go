@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) LegalMoves(goCtx context.Context, req *types.QueryLegalMovesRequest) (*types.QueryLegalMovesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.GetStoredGame(ctx, req.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", req.GameIndex)
+	}
+
+	// Nobody can move in a game that is over.
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return &types.QueryLegalMovesResponse{
+			Player: rules.PieceStrings[rules.NO_PLAYER],
+		}, nil
+	}
+
+	game, err := storedGame.ParseGame()
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryLegalMovesResponse{
+		Player: storedGame.Turn,
+		Moves:  toLegalMoves(game.LegalMoves(game.Turn)),
+	}, nil
+}
+
+func toLegalMoves(moves []rules.Move) []types.LegalMove {
+	var legalMoves []types.LegalMove
+	for _, move := range moves {
+		legalMoves = append(legalMoves, types.LegalMove{
+			FromX:     uint64(move.Src.X),
+			FromY:     uint64(move.Src.Y),
+			ToX:       uint64(move.Dst.X),
+			ToY:       uint64(move.Dst.Y),
+			CapturedX: int32(move.Captured.X),
+			CapturedY: int32(move.Captured.Y),
+			Then:      toLegalMoves(move.Then),
+		})
+	}
+	return legalMoves
+}
@@ -0,0 +1,298 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+type legalMovesCase struct {
+	desc     string
+	game     types.StoredGame
+	request  *types.QueryLegalMovesRequest
+	response *types.QueryLegalMovesResponse
+	err      string
+}
+
+var legalMovesTestRange = []legalMovesCase{
+	{
+		desc: "First moves of black",
+		game: types.StoredGame{
+			Index:  "1",
+			Board:  "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+			Turn:   "b",
+			Winner: "*",
+		},
+		request: &types.QueryLegalMovesRequest{GameIndex: "1"},
+		response: &types.QueryLegalMovesResponse{
+			Player: "b",
+			Moves: []types.LegalMove{
+				{FromX: 1, FromY: 2, ToX: 0, ToY: 3, CapturedX: -1, CapturedY: -1},
+				{FromX: 1, FromY: 2, ToX: 2, ToY: 3, CapturedX: -1, CapturedY: -1},
+				{FromX: 3, FromY: 2, ToX: 2, ToY: 3, CapturedX: -1, CapturedY: -1},
+				{FromX: 3, FromY: 2, ToX: 4, ToY: 3, CapturedX: -1, CapturedY: -1},
+				{FromX: 5, FromY: 2, ToX: 4, ToY: 3, CapturedX: -1, CapturedY: -1},
+				{FromX: 5, FromY: 2, ToX: 6, ToY: 3, CapturedX: -1, CapturedY: -1},
+				{FromX: 7, FromY: 2, ToX: 6, ToY: 3, CapturedX: -1, CapturedY: -1},
+			},
+		},
+		err: "nil",
+	},
+	{
+		desc: "Black must capture",
+		game: types.StoredGame{
+			Index:  "1",
+			Board:  "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+			Turn:   "b",
+			Winner: "*",
+		},
+		request: &types.QueryLegalMovesRequest{GameIndex: "1"},
+		response: &types.QueryLegalMovesResponse{
+			Player: "b",
+			Moves: []types.LegalMove{
+				{FromX: 2, FromY: 3, ToX: 0, ToY: 5, CapturedX: 1, CapturedY: 4},
+			},
+		},
+		err: "nil",
+	},
+	{
+		desc: "Black can capture twice in a row",
+		game: types.StoredGame{
+			Index:  "1",
+			Board:  "********|********|*b******|**r*****|********|****r***|********|********",
+			Turn:   "b",
+			Winner: "*",
+		},
+		request: &types.QueryLegalMovesRequest{GameIndex: "1"},
+		response: &types.QueryLegalMovesResponse{
+			Player: "b",
+			Moves: []types.LegalMove{
+				{FromX: 1, FromY: 2, ToX: 3, ToY: 4, CapturedX: 2, CapturedY: 3, Then: []types.LegalMove{
+					{FromX: 3, FromY: 4, ToX: 5, ToY: 6, CapturedX: 4, CapturedY: 5},
+				}},
+			},
+		},
+		err: "nil",
+	},
+	{
+		desc: "Red to play",
+		game: types.StoredGame{
+			Index:  "1",
+			Board:  "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+			Turn:   "r",
+			Winner: "*",
+		},
+		request: &types.QueryLegalMovesRequest{GameIndex: "1"},
+		response: &types.QueryLegalMovesResponse{
+			Player: "r",
+			Moves: []types.LegalMove{
+				{FromX: 0, FromY: 5, ToX: 1, ToY: 4, CapturedX: -1, CapturedY: -1},
+				{FromX: 2, FromY: 5, ToX: 1, ToY: 4, CapturedX: -1, CapturedY: -1},
+				{FromX: 2, FromY: 5, ToX: 3, ToY: 4, CapturedX: -1, CapturedY: -1},
+				{FromX: 4, FromY: 5, ToX: 3, ToY: 4, CapturedX: -1, CapturedY: -1},
+				{FromX: 4, FromY: 5, ToX: 5, ToY: 4, CapturedX: -1, CapturedY: -1},
+				{FromX: 6, FromY: 5, ToX: 5, ToY: 4, CapturedX: -1, CapturedY: -1},
+				{FromX: 6, FromY: 5, ToX: 7, ToY: 4, CapturedX: -1, CapturedY: -1},
+			},
+		},
+		err: "nil",
+	},
+	{
+		desc: "Russian king flies along the diagonal",
+		game: types.StoredGame{
+			Index:   "1",
+			Board:   "*b******|********|********|********|********|********|********|R*******",
+			Turn:    "r",
+			Winner:  "*",
+			Variant: "russian",
+		},
+		request: &types.QueryLegalMovesRequest{GameIndex: "1"},
+		response: &types.QueryLegalMovesResponse{
+			Player: "r",
+			Moves: []types.LegalMove{
+				{FromX: 0, FromY: 7, ToX: 7, ToY: 0, CapturedX: -1, CapturedY: -1},
+				{FromX: 0, FromY: 7, ToX: 6, ToY: 1, CapturedX: -1, CapturedY: -1},
+				{FromX: 0, FromY: 7, ToX: 5, ToY: 2, CapturedX: -1, CapturedY: -1},
+				{FromX: 0, FromY: 7, ToX: 4, ToY: 3, CapturedX: -1, CapturedY: -1},
+				{FromX: 0, FromY: 7, ToX: 3, ToY: 4, CapturedX: -1, CapturedY: -1},
+				{FromX: 0, FromY: 7, ToX: 2, ToY: 5, CapturedX: -1, CapturedY: -1},
+				{FromX: 0, FromY: 7, ToX: 1, ToY: 6, CapturedX: -1, CapturedY: -1},
+			},
+		},
+		err: "nil",
+	},
+	{
+		desc: "Russian king captures from afar",
+		game: types.StoredGame{
+			Index:   "1",
+			Board:   "*b******|********|********|********|***b****|********|********|R*******",
+			Turn:    "r",
+			Winner:  "*",
+			Variant: "russian",
+		},
+		request: &types.QueryLegalMovesRequest{GameIndex: "1"},
+		response: &types.QueryLegalMovesResponse{
+			Player: "r",
+			Moves: []types.LegalMove{
+				{FromX: 0, FromY: 7, ToX: 7, ToY: 0, CapturedX: 3, CapturedY: 4},
+				{FromX: 0, FromY: 7, ToX: 6, ToY: 1, CapturedX: 3, CapturedY: 4},
+				{FromX: 0, FromY: 7, ToX: 5, ToY: 2, CapturedX: 3, CapturedY: 4},
+				{FromX: 0, FromY: 7, ToX: 4, ToY: 3, CapturedX: 3, CapturedY: 4},
+			},
+		},
+		err: "nil",
+	},
+	{
+		desc: "American man cannot capture backwards",
+		game: types.StoredGame{
+			Index:  "1",
+			Board:  "*b******|********|********|********|***r****|****b***|********|********",
+			Turn:   "r",
+			Winner: "*",
+		},
+		request: &types.QueryLegalMovesRequest{GameIndex: "1"},
+		response: &types.QueryLegalMovesResponse{
+			Player: "r",
+			Moves: []types.LegalMove{
+				{FromX: 3, FromY: 4, ToX: 2, ToY: 3, CapturedX: -1, CapturedY: -1},
+				{FromX: 3, FromY: 4, ToX: 4, ToY: 3, CapturedX: -1, CapturedY: -1},
+			},
+		},
+		err: "nil",
+	},
+	{
+		desc: "Pool man has to capture backwards, same board as previous",
+		game: types.StoredGame{
+			Index:   "1",
+			Board:   "*b******|********|********|********|***r****|****b***|********|********",
+			Turn:    "r",
+			Winner:  "*",
+			Variant: "pool",
+		},
+		request: &types.QueryLegalMovesRequest{GameIndex: "1"},
+		response: &types.QueryLegalMovesResponse{
+			Player: "r",
+			Moves: []types.LegalMove{
+				{FromX: 3, FromY: 4, ToX: 5, ToY: 6, CapturedX: 4, CapturedY: 5},
+			},
+		},
+		err: "nil",
+	},
+	{
+		desc: "Russian men capture backwards and pick any sequence",
+		game: types.StoredGame{
+			Index:   "1",
+			Board:   "********|********|********|****b*b*|*******r|**b*****|*r******|********",
+			Turn:    "r",
+			Winner:  "*",
+			Variant: "russian",
+		},
+		request: &types.QueryLegalMovesRequest{GameIndex: "1"},
+		response: &types.QueryLegalMovesResponse{
+			Player: "r",
+			Moves: []types.LegalMove{
+				{FromX: 7, FromY: 4, ToX: 5, ToY: 2, CapturedX: 6, CapturedY: 3, Then: []types.LegalMove{
+					{FromX: 5, FromY: 2, ToX: 3, ToY: 4, CapturedX: 4, CapturedY: 3},
+				}},
+				{FromX: 1, FromY: 6, ToX: 3, ToY: 4, CapturedX: 2, CapturedY: 5, Then: []types.LegalMove{
+					{FromX: 3, FromY: 4, ToX: 5, ToY: 2, CapturedX: 4, CapturedY: 3},
+				}},
+			},
+		},
+		err: "nil",
+	},
+	{
+		desc: "Italian player has to capture the most, same board as previous",
+		game: types.StoredGame{
+			Index:   "1",
+			Board:   "********|********|********|****b*b*|*******r|**b*****|*r******|********",
+			Turn:    "r",
+			Winner:  "*",
+			Variant: "italian",
+		},
+		request: &types.QueryLegalMovesRequest{GameIndex: "1"},
+		response: &types.QueryLegalMovesResponse{
+			Player: "r",
+			Moves: []types.LegalMove{
+				{FromX: 1, FromY: 6, ToX: 3, ToY: 4, CapturedX: 2, CapturedY: 5, Then: []types.LegalMove{
+					{FromX: 3, FromY: 4, ToX: 5, ToY: 2, CapturedX: 4, CapturedY: 3},
+				}},
+			},
+		},
+		err: "nil",
+	},
+	{
+		desc: "Unknown variant, wrong",
+		game: types.StoredGame{
+			Index:   "1",
+			Board:   "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+			Turn:    "b",
+			Winner:  "*",
+			Variant: "chess",
+		},
+		request:  &types.QueryLegalMovesRequest{GameIndex: "1"},
+		response: nil,
+		err:      "chess: unknown rules variant: %s",
+	},
+	{
+		desc: "Game finished",
+		game: types.StoredGame{
+			Index:  "1",
+			Board:  "",
+			Turn:   "b",
+			Winner: "r",
+		},
+		request:  &types.QueryLegalMovesRequest{GameIndex: "1"},
+		response: &types.QueryLegalMovesResponse{Player: "*"},
+		err:      "nil",
+	},
+	{
+		desc: "Nil request, wrong",
+		game: types.StoredGame{
+			Index:  "1",
+			Board:  "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+			Turn:   "b",
+			Winner: "*",
+		},
+		request:  nil,
+		response: nil,
+		err:      "rpc error: code = InvalidArgument desc = invalid request",
+	},
+	{
+		desc: "Wrong game index, wrong",
+		game: types.StoredGame{
+			Index:  "1",
+			Board:  "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+			Turn:   "b",
+			Winner: "*",
+		},
+		request:  &types.QueryLegalMovesRequest{GameIndex: "2"},
+		response: nil,
+		err:      "2: game by id not found",
+	},
+}
+
+func TestLegalMovesCasesAsExpected(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+	for _, testCase := range legalMovesTestRange {
+		t.Run(testCase.desc, func(t *testing.T) {
+			keeper.SetStoredGame(ctx, testCase.game)
+			response, err := keeper.LegalMoves(goCtx, testCase.request)
+			if testCase.response == nil {
+				require.Nil(t, response)
+			} else {
+				require.EqualValues(t, testCase.response, response)
+			}
+			if testCase.err == "nil" {
+				require.Nil(t, err)
+			} else {
+				require.EqualError(t, err, testCase.err)
+			}
+			keeper.RemoveStoredGame(ctx, testCase.game.Index)
+		})
+	}
+}
@@ -16,8 +16,12 @@ func (k Keeper) StoredGameAll(c context.Context, req *types.QueryAllStoredGameRe
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	var storedGames []types.StoredGame
 	ctx := sdk.UnwrapSDKContext(c)
+	if isListingFiltered(req) {
+		return k.storedGameListing(ctx, req)
+	}
+
+	var storedGames []types.StoredGame
 
 	store := ctx.KVStore(k.storeKey)
 	storedGameStore := prefix.NewStore(store, types.KeyPrefix(types.StoredGameKeyPrefix))
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) TournamentAll(c context.Context, req *types.QueryAllTournamentRequest) (*types.QueryAllTournamentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var tournaments []types.Tournament
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	tournamentStore := prefix.NewStore(store, types.KeyPrefix(types.TournamentKeyPrefix))
+
+	pageRes, err := query.Paginate(tournamentStore, req.Pagination, func(key []byte, value []byte) error {
+		var tournament types.Tournament
+		if err := k.cdc.Unmarshal(value, &tournament); err != nil {
+			return err
+		}
+
+		tournaments = append(tournaments, tournament)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTournamentResponse{Tournament: tournaments, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Tournament(c context.Context, req *types.QueryGetTournamentRequest) (*types.QueryGetTournamentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetTournament(
+		ctx,
+		req.Index,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetTournamentResponse{Tournament: val}, nil
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) TournamentStandings(goCtx context.Context, req *types.QueryTournamentStandingsRequest) (*types.QueryTournamentStandingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tournament, found := k.GetTournament(ctx, req.Index)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryTournamentStandingsResponse{Standings: tournament.Standings()}, nil
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/alice/checkers/x/checkers/types"
+)
+
+func TestTournamentQuerySingle(t *testing.T) {
+	msgServer, keeper, ctx, ctrl, escrow := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	expectAnyTournamentTransfer(escrow, ctx)
+	createTournament(msgServer, ctx, types.TournamentFormatRoundRobin, 0, alice, bob)
+	wctx := sdk.WrapSDKContext(ctx)
+	tournament, _ := keeper.GetTournament(ctx, "1")
+
+	response, err := keeper.Tournament(wctx, &types.QueryGetTournamentRequest{Index: "1"})
+	require.Nil(t, err)
+	require.Equal(t, tournament, response.Tournament)
+
+	_, err = keeper.Tournament(wctx, &types.QueryGetTournamentRequest{Index: "2"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+	_, err = keeper.Tournament(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestTournamentQueryAll(t *testing.T) {
+	msgServer, keeper, ctx, ctrl, _ := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	for i := 0; i < 3; i++ {
+		createTournament(msgServer, ctx, types.TournamentFormatRoundRobin, 0)
+	}
+
+	response, err := keeper.TournamentAll(sdk.WrapSDKContext(ctx), &types.QueryAllTournamentRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, 3, response.Pagination.Total)
+	require.Len(t, response.Tournament, 2)
+	require.Equal(t, "1", response.Tournament[0].Index)
+	require.Equal(t, "2", response.Tournament[1].Index)
+}
+
+func TestTournamentStandingsQuery(t *testing.T) {
+	msgServer, keeper, ctx, ctrl, escrow := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	expectAnyTournamentTransfer(escrow, ctx)
+	createTournament(msgServer, ctx, types.TournamentFormatSingleElimination, 0, alice, bob, carol)
+	started, expired := tournamentContexts(ctx)
+	keeper.StartTournaments(started)
+	keeper.ForfeitExpiredGames(expired)
+
+	response, err := keeper.TournamentStandings(expired, &types.QueryTournamentStandingsRequest{Index: "1"})
+	require.Nil(t, err)
+	require.EqualValues(t, []types.TournamentStanding{
+		{Player: alice, Points: 2},
+		{Player: carol, PlayedCount: 1, WonCount: 1, Points: 2},
+		{Player: bob, PlayedCount: 1, LostCount: 1},
+	}, response.Standings)
+
+	_, err = keeper.TournamentStandings(expired, &types.QueryTournamentStandingsRequest{Index: "2"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "not found"))
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) AcceptChallenge(goCtx context.Context, msg *types.MsgAcceptChallenge) (*types.MsgAcceptChallengeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	challenge, found := k.Keeper.GetChallenge(ctx, msg.ChallengeIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrChallengeNotFound, "%s", msg.ChallengeIndex)
+	}
+	if challenge.Creator == msg.Creator {
+		return nil, types.ErrCannotAcceptOwnChallenge
+	}
+	// Not all expired challenges are removed in the block their deadline passes.
+	deadline, err := challenge.GetDeadlineAsTime()
+	if err != nil {
+		panic(err)
+	}
+	if deadline.Before(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrChallengeExpired, "%s", challenge.Deadline)
+	}
+
+	// The accepting player takes the seat left free.
+	black, red := challenge.Creator, msg.Creator
+	if challenge.Color == rules.PieceStrings[rules.RED_PLAYER] {
+		black, red = msg.Creator, challenge.Creator
+	}
+	err = k.createGame(ctx, challenge.Index, msg.Creator, black, red, challenge.Wager, challenge.Denom, challenge.Variant, "", types.TimeControl{}, true)
+	if err != nil {
+		return nil, err
+	}
+	k.Keeper.RemoveChallenge(ctx, challenge.Index)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.ChallengeAcceptedEventType,
+			sdk.NewAttribute(types.ChallengeAcceptedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.ChallengeAcceptedEventChallengeIndex, challenge.Index),
+			sdk.NewAttribute(types.ChallengeAcceptedEventGameIndex, challenge.Index),
+		),
+	)
+
+	return &types.MsgAcceptChallengeResponse{
+		GameIndex: challenge.Index,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AcceptDraw(goCtx context.Context, msg *types.MsgAcceptDraw) (*types.MsgAcceptDrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, err := k.Keeper.getDrawOfferToAnswer(ctx, msg.GameIndex, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+
+	// The game is over, nobody wins and each player gets their wager back.
+	storedGame.Winner = rules.PieceStrings[rules.DRAW_PLAYER]
+	storedGame.DrawOffer = ""
+	storedGame.PositionHistory = nil
+	storedGame.MovesWithoutProgress = 0
+	storedGame.CapturingSquare = 0
+	storedGame.CapturedSquares = nil
+	k.Keeper.MustRefundWager(ctx, &storedGame)
+	k.Keeper.MustRegisterGameResult(ctx, &storedGame, false)
+	if err := k.Keeper.RegisterTournamentResult(ctx, &storedGame, &systemInfo); err != nil {
+		return nil, err
+	}
+	storedGame.Status = types.GameStatusFinished
+
+	k.Keeper.SetStoredGame(ctx, storedGame)
+	k.Keeper.SetSystemInfo(ctx, systemInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameDrawnEventType,
+			sdk.NewAttribute(types.GameDrawnEventCreator, msg.Creator),
+			sdk.NewAttribute(types.GameDrawnEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.GameDrawnEventBoard, storedGame.Board),
+		),
+	)
+
+	return &types.MsgAcceptDrawResponse{}, nil
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcceptDrawNoOffer(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	acceptDrawResponse, err := msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, acceptDrawResponse)
+	require.Equal(t, "there is no draw offer to answer", err.Error())
+}
+
+func TestAcceptDrawOwnOffer(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	acceptDrawResponse, err := msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, acceptDrawResponse)
+	require.Equal(t, "player cannot answer their own draw offer", err.Error())
+}
+
+func TestAcceptDrawAfterTwoMovesSaved(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectRefund(context, bob, 45).Times(1)
+	escrow.ExpectRefund(context, carol, 45).Times(1)
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	acceptDrawResponse, err := msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgAcceptDrawResponse{}, *acceptDrawResponse)
+
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId: 2,
+	}, systemInfo)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "d", game.Winner)
+	require.Equal(t, "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*", game.Board)
+	require.Equal(t, "", game.DrawOffer)
+}
+
+func TestAcceptDrawEmitted(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectRefund(context, bob, 45).Times(1)
+	escrow.ExpectRefund(context, carol, 45).Times(1)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 4)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-drawn",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: bob},
+			{Key: "game-index", Value: "1"},
+			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
+		},
+	}, events[2])
+}
+
+func TestAcceptDrawThenCannotPlay(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	escrow.ExpectRefund(context, bob, 45).Times(1)
+	escrow.ExpectRefund(context, carol, 45).Times(1)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	msgServer.AcceptDraw(context, &types.MsgAcceptDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, playMoveResponse)
+	require.Equal(t, "game is already finished", err.Error())
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) AcceptGame(goCtx context.Context, msg *types.MsgAcceptGame) (*types.MsgAcceptGameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+	if storedGame.Status != types.GameStatusPending {
+		return nil, types.ErrGameNotPending
+	}
+
+	// The creator has escrowed their stake already, only their opponent can accept.
+	var color string
+	if storedGame.Creator == msg.Creator {
+		return nil, types.ErrCannotAcceptOwnGame
+	} else if storedGame.Black == msg.Creator {
+		color = rules.PieceStrings[rules.BLACK_PLAYER]
+	} else if storedGame.Red == msg.Creator {
+		color = rules.PieceStrings[rules.RED_PLAYER]
+	} else {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	}
+
+	err := k.Keeper.CollectWager(ctx, &storedGame, color)
+	if err != nil {
+		return nil, err
+	}
+
+	// The first player gets a full turn, or their full clock, from now on.
+	storedGame.Status = types.GameStatusActive
+	storedGame.Deadline = types.FormatDeadline(storedGame.GetNextDeadline(ctx))
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameAcceptedEventType,
+			sdk.NewAttribute(types.GameAcceptedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.GameAcceptedEventGameIndex, msg.GameIndex),
+		),
+	)
+
+	return &types.MsgAcceptGameResponse{}, nil
+}
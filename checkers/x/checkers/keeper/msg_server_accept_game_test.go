@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/checkers"
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/testutil"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithOneGameForAcceptGame(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectPay(context, bob, 45)
+	server.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+	})
+	return server, *k, context, ctrl, bankMock
+}
+
+func TestAcceptGame(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForAcceptGame(t)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, carol, 45).Times(1)
+	acceptGameResponse, err := msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgAcceptGameResponse{}, *acceptGameResponse)
+}
+
+func TestAcceptGameSaved(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForAcceptGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, carol, 45).Times(1)
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, types.StoredGame{
+		Index:       "1",
+		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:        "b",
+		Black:       bob,
+		Red:         carol,
+		MoveCount:   uint64(0),
+		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl: types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
+		Winner:      "*",
+		Wager:       45,
+		Denom:       "stake",
+		Status:      types.GameStatusActive,
+		Creator:     bob,
+	}, game)
+}
+
+func TestAcceptGameEmitted(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForAcceptGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, carol, 45).Times(1)
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 2)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "game-accepted",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: carol},
+			{Key: "game-index", Value: "1"},
+		},
+	}, events[0])
+}
+
+func TestAcceptGameOwnGame(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForAcceptGame(t)
+	defer ctrl.Finish()
+	acceptGameResponse, err := msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, acceptGameResponse)
+	require.Equal(t, "player cannot accept their own game", err.Error())
+}
+
+func TestAcceptGameByNonPlayer(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForAcceptGame(t)
+	defer ctrl.Finish()
+	acceptGameResponse, err := msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   alice,
+		GameIndex: "1",
+	})
+	require.Nil(t, acceptGameResponse)
+	require.Equal(t, alice+": message creator is not a player", err.Error())
+}
+
+func TestAcceptGameTwice(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForAcceptGame(t)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, carol, 45).Times(1)
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	acceptGameResponse, err := msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, acceptGameResponse)
+	require.Equal(t, "game is not waiting to be accepted", err.Error())
+}
+
+func TestAcceptGameUnknown(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForAcceptGame(t)
+	defer ctrl.Finish()
+	acceptGameResponse, err := msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "2",
+	})
+	require.Nil(t, acceptGameResponse)
+	require.Equal(t, "2: game by id not found", err.Error())
+}
+
+func TestPlayMoveBeforeAccepted(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForAcceptGame(t)
+	defer ctrl.Finish()
+	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, playMoveResponse)
+	require.Equal(t, "game has not been accepted yet", err.Error())
+}
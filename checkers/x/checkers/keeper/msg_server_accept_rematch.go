@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AcceptRematch(goCtx context.Context, msg *types.MsgAcceptRematch) (*types.MsgAcceptRematchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, err := k.Keeper.getRematchOfferToAnswer(ctx, msg.GameIndex, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+
+	// The rematch is played with colors swapped, on the same terms. Both players agreed to it, so
+	// that it starts at once with both stakes in escrow, the one who offered it paying theirs too.
+	newIndex := strconv.FormatUint(systemInfo.NextId, 10)
+	err = k.Keeper.createGame(ctx, newIndex, msg.Creator, storedGame.Red, storedGame.Black,
+		storedGame.Wager, storedGame.Denom, storedGame.Variant, storedGame.Fen, storedGame.TimeControl, true)
+	if err != nil {
+		return nil, err
+	}
+	rematch, found := k.Keeper.GetStoredGame(ctx, newIndex)
+	if !found {
+		panic("Rematch not found " + newIndex)
+	}
+	if rematch.Black != rematch.Red {
+		offerer := rules.PieceStrings[rules.BLACK_PLAYER]
+		if rematch.Black == msg.Creator {
+			offerer = rules.PieceStrings[rules.RED_PLAYER]
+		}
+		if err := k.Keeper.CollectWager(ctx, &rematch, offerer); err != nil {
+			return nil, err
+		}
+	}
+	rematch.PreviousGame = storedGame.Index
+	k.Keeper.SetStoredGame(ctx, rematch)
+
+	storedGame.RematchOffer = ""
+	storedGame.RematchGame = newIndex
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	systemInfo.NextId++
+	k.Keeper.SetSystemInfo(ctx, systemInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.RematchAcceptedEventType,
+			sdk.NewAttribute(types.RematchAcceptedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.RematchAcceptedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.RematchAcceptedEventRematchIndex, newIndex),
+		),
+	)
+
+	return &types.MsgAcceptRematchResponse{
+		GameIndex: newIndex,
+	}, nil
+}
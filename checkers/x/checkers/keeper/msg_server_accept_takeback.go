@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AcceptTakeback(goCtx context.Context, msg *types.MsgAcceptTakeback) (*types.MsgAcceptTakebackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, err := k.Keeper.getTakebackRequestToAnswer(ctx, msg.GameIndex, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	// The param may have been lowered since the request.
+	if k.Keeper.MaxTakebacks(ctx) <= storedGame.TakebackCount {
+		return nil, types.ErrNoTakebacksLeft
+	}
+	gameMoves, err := k.Keeper.getGameMovesToTakeBack(ctx, storedGame)
+	if err != nil {
+		return nil, err
+	}
+
+	// The player to move is charged the time they used so far, before the position they are in goes.
+	if err := storedGame.StopClock(ctx, false); err != nil {
+		panic(err.Error())
+	}
+	k.Keeper.mustTakeBack(ctx, &storedGame, gameMoves, storedGame.TakebackPlies)
+	storedGame.TakebackRequest = ""
+	storedGame.TakebackPlies = 0
+	storedGame.TakebackCount++
+	storedGame.DrawOffer = ""
+	// The player to move again gets a whole turn to play.
+	storedGame.Deadline = types.FormatDeadline(storedGame.GetNextDeadline(ctx))
+
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.TakebackAcceptedEventType,
+			sdk.NewAttribute(types.TakebackAcceptedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.TakebackAcceptedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.TakebackAcceptedEventBoard, storedGame.Board),
+		),
+	)
+
+	return &types.MsgAcceptTakebackResponse{}, nil
+}
@@ -0,0 +1,422 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/checkers"
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/testutil"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateChallenge(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	response, err := msgServer.CreateChallenge(context, &types.MsgCreateChallenge{
+		Creator: bob,
+		Color:   "b",
+		Wager:   45,
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgCreateChallengeResponse{
+		ChallengeIndex: "1",
+	}, *response)
+
+	challenge, found := keeper.GetChallenge(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, types.Challenge{
+		Index:    "1",
+		Creator:  bob,
+		Color:    "b",
+		Wager:    45,
+		Deadline: types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxChallengeDuration)),
+	}, challenge)
+
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId: 2,
+	}, systemInfo)
+
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 1)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "challenge-created",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: bob},
+			{Key: "challenge-index", Value: "1"},
+			{Key: "color", Value: "b"},
+			{Key: "wager", Value: "45"},
+		},
+	}, events[0])
+}
+
+func TestCreateGameAfterChallengeTakesNextIndex(t *testing.T) {
+	msgServer, _, context := setupMsgServerCreateGame(t)
+	msgServer.CreateChallenge(context, &types.MsgCreateChallenge{
+		Creator: bob,
+		Color:   "b",
+		Wager:   45,
+	})
+	response, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+	})
+	require.Nil(t, err)
+	require.Equal(t, "2", response.GameIndex)
+}
+
+func TestAcceptChallengeBlackSeatTaken(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.CreateChallenge(context, &types.MsgCreateChallenge{
+		Creator: bob,
+		Color:   "b",
+		Wager:   45,
+		Variant: "italian",
+	})
+	response, err := msgServer.AcceptChallenge(context, &types.MsgAcceptChallenge{
+		Creator:        carol,
+		ChallengeIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgAcceptChallengeResponse{
+		GameIndex: "1",
+	}, *response)
+
+	_, found := keeper.GetChallenge(ctx, "1")
+	require.False(t, found)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, bob, game.Black)
+	require.Equal(t, carol, game.Red)
+	require.EqualValues(t, 45, game.Wager)
+	require.Equal(t, "italian", game.Variant)
+	require.Equal(t, "r", game.Turn)
+
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId: 2,
+	}, systemInfo)
+}
+
+func TestAcceptChallengeRedSeatTaken(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.CreateChallenge(context, &types.MsgCreateChallenge{
+		Creator: bob,
+		Color:   "r",
+		Wager:   45,
+	})
+	msgServer.AcceptChallenge(context, &types.MsgAcceptChallenge{
+		Creator:        carol,
+		ChallengeIndex: "1",
+	})
+
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, carol, game.Black)
+	require.Equal(t, bob, game.Red)
+}
+
+func TestAcceptChallengeKeepsDenom(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	keeper.SetParams(ctx, types.NewParams([]string{"stake", "ibc/ATOM"}, types.DefaultMaxTurnDuration, types.DefaultCreateGameGas, types.DefaultPlayMoveGas, types.DefaultRejectGameRefundGas, types.DefaultMinTimeControl, types.DefaultMaxTimeControl, types.DefaultLeaderboardLength, types.DefaultLeaderboardRefreshInterval, types.DefaultMaxTakebacks, types.DefaultMaxMovesWithoutProgress, types.DefaultMaxChallengeDuration, types.DefaultMaxForfeitsPerBlock, types.DefaultMaxChallengeExpiriesPerBlock))
+	msgServer.CreateChallenge(context, &types.MsgCreateChallenge{
+		Creator: bob,
+		Color:   "b",
+		Wager:   45,
+		Denom:   "ibc/ATOM",
+	})
+	_, err := msgServer.AcceptChallenge(context, &types.MsgAcceptChallenge{
+		Creator:        carol,
+		ChallengeIndex: "1",
+	})
+	require.Nil(t, err)
+
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "ibc/ATOM", game.Denom)
+}
+
+func TestCreateChallengeDenomNotAllowed(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	response, err := msgServer.CreateChallenge(context, &types.MsgCreateChallenge{
+		Creator: bob,
+		Color:   "b",
+		Wager:   45,
+		Denom:   "ibc/ATOM",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "ibc/ATOM: denomination not allowed for wagers: %s", err.Error())
+	_, found := keeper.GetChallenge(sdk.UnwrapSDKContext(context), "1")
+	require.False(t, found)
+}
+
+func TestAcceptChallengeEmitted(t *testing.T) {
+	msgServer, _, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.CreateChallenge(context, &types.MsgCreateChallenge{
+		Creator: bob,
+		Color:   "b",
+		Wager:   45,
+	})
+	msgServer.AcceptChallenge(context, &types.MsgAcceptChallenge{
+		Creator:        carol,
+		ChallengeIndex: "1",
+	})
+
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 3)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "challenge-accepted",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: carol},
+			{Key: "challenge-index", Value: "1"},
+			{Key: "game-index", Value: "1"},
+		},
+	}, events[0])
+	require.Equal(t, "new-game-created", events[2].Type)
+}
+
+func TestAcceptOwnChallenge(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.CreateChallenge(context, &types.MsgCreateChallenge{
+		Creator: bob,
+		Color:   "b",
+		Wager:   45,
+	})
+	response, err := msgServer.AcceptChallenge(context, &types.MsgAcceptChallenge{
+		Creator:        bob,
+		ChallengeIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "player cannot accept their own challenge", err.Error())
+	_, found := keeper.GetChallenge(ctx, "1")
+	require.True(t, found)
+}
+
+func TestAcceptUnknownChallenge(t *testing.T) {
+	msgServer, _, context := setupMsgServerCreateGame(t)
+	for _, index := range []string{"1", "abc"} {
+		response, err := msgServer.AcceptChallenge(context, &types.MsgAcceptChallenge{
+			Creator:        carol,
+			ChallengeIndex: index,
+		})
+		require.Nil(t, response)
+		require.Equal(t, index+": challenge by id not found", err.Error())
+	}
+}
+
+func TestAcceptExpiredChallenge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	escrow := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, escrow)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	msgServer := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	atDeadline := sdk.WrapSDKContext(ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultMaxChallengeDuration)))
+	afterDeadline := sdk.WrapSDKContext(ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultMaxChallengeDuration + 1)))
+	// Nothing is escrowed when accepting after the deadline.
+	escrow.ExpectPay(context, bob, 45)
+	escrow.ExpectPay(atDeadline, carol, 45)
+	msgServer.CreateChallenge(context, &types.MsgCreateChallenge{
+		Creator: bob,
+		Color:   "b",
+		Wager:   45,
+	})
+
+	response, err := msgServer.AcceptChallenge(afterDeadline, &types.MsgAcceptChallenge{
+		Creator:        carol,
+		ChallengeIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxChallengeDuration))+": challenge expired", err.Error())
+	_, found := k.GetChallenge(ctx, "1")
+	require.True(t, found)
+
+	response, err = msgServer.AcceptChallenge(atDeadline, &types.MsgAcceptChallenge{
+		Creator:        carol,
+		ChallengeIndex: "1",
+	})
+	require.Nil(t, err)
+	require.Equal(t, "1", response.GameIndex)
+}
+
+func TestExpireChallengesOnlyPastDeadline(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	msgServer.CreateChallenge(context, &types.MsgCreateChallenge{
+		Creator: bob,
+		Color:   "b",
+		Wager:   45,
+	})
+	msgServer.CreateChallenge(context, &types.MsgCreateChallenge{
+		Creator: carol,
+		Color:   "r",
+		Wager:   45,
+	})
+	challenge1, _ := keeper.GetChallenge(ctx, "1")
+	challenge1.Deadline = types.FormatDeadline(ctx.BlockTime().Add(time.Duration(-1)))
+	keeper.SetChallenge(ctx, challenge1)
+
+	keeper.ExpireChallenges(context)
+
+	_, found := keeper.GetChallenge(ctx, "1")
+	require.False(t, found)
+	_, found = keeper.GetChallenge(ctx, "2")
+	require.True(t, found)
+
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "challenge-expired",
+		Attributes: []sdk.Attribute{
+			{Key: "challenge-index", Value: "1"},
+		},
+	}, events[len(events)-1])
+}
+
+func TestExpireChallengesAllAfterDuration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	escrow := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, escrow)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	msgServer := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	atDuration := sdk.WrapSDKContext(ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultMaxChallengeDuration)))
+	afterDuration := sdk.WrapSDKContext(ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultMaxChallengeDuration + 1)))
+	pay := escrow.ExpectPay(context, bob, 45).Times(3)
+	escrow.ExpectRefund(afterDuration, bob, 45).Times(3).After(pay)
+	for i := 0; i < 3; i++ {
+		msgServer.CreateChallenge(context, &types.MsgCreateChallenge{
+			Creator: bob,
+			Color:   "b",
+			Wager:   45,
+		})
+	}
+
+	k.ExpireChallenges(atDuration)
+	require.Len(t, k.GetAllChallenge(ctx), 3)
+	k.ExpireChallenges(afterDuration)
+	require.Len(t, k.GetAllChallenge(ctx), 0)
+}
+
+func TestExpireChallengesNoMoreThanMaxPerBlock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	escrow := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, escrow)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	msgServer := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	escrow.ExpectAny(context)
+	for i := 0; i <= int(types.DefaultMaxChallengeExpiriesPerBlock); i++ {
+		msgServer.CreateChallenge(context, &types.MsgCreateChallenge{
+			Creator: bob,
+			Color:   "b",
+			Wager:   45,
+		})
+	}
+	later := sdk.WrapSDKContext(ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultMaxChallengeDuration + 1)))
+	escrow.ExpectRefund(later, bob, 45).Times(int(types.DefaultMaxChallengeExpiriesPerBlock))
+	k.ExpireChallenges(later)
+	require.Len(t, k.GetAllChallenge(ctx), 1)
+
+	evenLater := sdk.WrapSDKContext(ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultMaxChallengeDuration + 2)))
+	escrow.ExpectRefund(evenLater, bob, 45).Times(1)
+	k.ExpireChallenges(evenLater)
+	require.Len(t, k.GetAllChallenge(ctx), 0)
+}
+
+func TestCreateChallengeDurationFromParams(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := keeper.GetParams(ctx)
+	params.MaxChallengeDuration = time.Hour
+	keeper.SetParams(ctx, params)
+
+	msgServer.CreateChallenge(context, &types.MsgCreateChallenge{
+		Creator: bob,
+		Color:   "b",
+		Wager:   45,
+	})
+
+	challenge, found := keeper.GetChallenge(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime().Add(time.Hour)), challenge.Deadline)
+}
+
+func TestExpireChallengesMaxPerBlockFromParams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	escrow := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, escrow)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	params := k.GetParams(ctx)
+	params.MaxChallengeExpiriesPerBlock = 2
+	k.SetParams(ctx, params)
+	msgServer := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	escrow.ExpectAny(context)
+	for i := 0; i < 3; i++ {
+		msgServer.CreateChallenge(context, &types.MsgCreateChallenge{
+			Creator: bob,
+			Color:   "b",
+			Wager:   45,
+		})
+	}
+	later := sdk.WrapSDKContext(ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultMaxChallengeDuration + 1)))
+	escrow.ExpectRefund(later, bob, 45).Times(2)
+	k.ExpireChallenges(later)
+	require.Len(t, k.GetAllChallenge(ctx), 1)
+}
+
+func TestOpenChallengesOldestFirst(t *testing.T) {
+	msgServer, keeper, context := setupMsgServerCreateGame(t)
+	for i := 0; i < 11; i++ {
+		msgServer.CreateChallenge(context, &types.MsgCreateChallenge{
+			Creator: bob,
+			Color:   "b",
+			Wager:   uint64(i),
+		})
+	}
+	msgServer.AcceptChallenge(context, &types.MsgAcceptChallenge{
+		Creator:        carol,
+		ChallengeIndex: "2",
+	})
+
+	first, err := keeper.OpenChallenges(context, &types.QueryOpenChallengesRequest{
+		Pagination: &query.PageRequest{Limit: 5, CountTotal: true},
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, 10, first.Pagination.Total)
+	var indices []string
+	for _, challenge := range first.Challenges {
+		indices = append(indices, challenge.Index)
+	}
+	second, err := keeper.OpenChallenges(context, &types.QueryOpenChallengesRequest{
+		Pagination: &query.PageRequest{Key: first.Pagination.NextKey, Limit: 5},
+	})
+	require.Nil(t, err)
+	for _, challenge := range second.Challenges {
+		indices = append(indices, challenge.Index)
+	}
+	expected := []string{"1"}
+	for i := 3; i <= 11; i++ {
+		expected = append(expected, strconv.Itoa(i))
+	}
+	require.Equal(t, expected, indices)
+}
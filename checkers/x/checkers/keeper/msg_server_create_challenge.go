@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) CreateChallenge(goCtx context.Context, msg *types.MsgCreateChallenge) (*types.MsgCreateChallengeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.Keeper.GetParams(ctx).IsAllowedDenom(types.WagerDenom(msg.Denom)) {
+		return nil, sdkerrors.Wrapf(types.ErrDenomNotAllowed, "%s", types.WagerDenom(msg.Denom))
+	}
+
+	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+
+	// The challenge takes the next game index, which its game keeps once accepted.
+	newIndex := strconv.FormatUint(systemInfo.NextId, 10)
+	challenge := types.Challenge{
+		Index:    newIndex,
+		Creator:  msg.Creator,
+		Color:    msg.Color,
+		Wager:    msg.Wager,
+		Variant:  msg.Variant,
+		Deadline: types.FormatDeadline(ctx.BlockTime().Add(k.MaxChallengeDuration(ctx))),
+		Denom:    msg.Denom,
+	}
+	// The creator's stake waits in escrow for the game, or for the challenge to expire.
+	if err := k.Keeper.CollectChallengeWager(ctx, &challenge); err != nil {
+		return nil, err
+	}
+	k.Keeper.SetChallenge(ctx, challenge)
+
+	systemInfo.NextId++
+	k.Keeper.SetSystemInfo(ctx, systemInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.ChallengeCreatedEventType,
+			sdk.NewAttribute(types.ChallengeCreatedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.ChallengeCreatedEventChallengeIndex, newIndex),
+			sdk.NewAttribute(types.ChallengeCreatedEventColor, msg.Color),
+			sdk.NewAttribute(types.ChallengeCreatedEventWager, strconv.FormatUint(msg.Wager, 10)),
+		),
+	)
+
+	return &types.MsgCreateChallengeResponse{
+		ChallengeIndex: newIndex,
+	}, nil
+}
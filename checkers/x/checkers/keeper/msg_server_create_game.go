@@ -7,6 +7,7 @@ import (
 	"github.com/alice/checkers/rules"
 	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func (k msgServer) CreateGame(goCtx context.Context, msg *types.MsgCreateGame) (*types.MsgCreateGameResponse, error) {
@@ -22,58 +23,150 @@ func (k msgServer) CreateGame(goCtx context.Context, msg *types.MsgCreateGame) (
 
 	newIndex := strconv.FormatUint(systemInfo.NextId, 10)
 
-	newGame := rules.New()
+	err := k.createGame(ctx, newIndex, msg.Creator, msg.Black, msg.Red, msg.Wager.Amount.Uint64(), msg.Wager.Denom, msg.Variant, msg.Fen, msg.TimeControl, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare the ground work for the next game using Keeper.SetSystemInfo function
+	// created by Ignite CLI
+	systemInfo.NextId++
+	k.Keeper.SetSystemInfo(ctx, systemInfo)
+
+	// Return the newley creatd id for reference.
+	return &types.MsgCreateGameResponse{
+		GameIndex: newIndex,
+	}, nil
+}
+
+// Creates and saves the game at the given index, the caller is left to save the system info.
+// The creator escrows the stake of each seat they take. The game waits for the opponent to
+// accept it, unless the opponent's stake is already in escrow as with challenges. Without a time
+// control, each move is limited to the max turn duration param.
+func (k Keeper) createGame(ctx sdk.Context, newIndex string, creator string, black string, red string, wager uint64, denom string, variantName string, fenString string, timeControl types.TimeControl, opponentPaid bool) error {
+	if creator != black && creator != red {
+		return sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", creator)
+	}
+	// Games that do not name a variant are played with American rules.
+	variant, found := rules.Variants[variantName]
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownVariant, "%s", variantName)
+	}
+	params := k.GetParams(ctx)
+	if err := params.ValidateTimeControls(); err != nil {
+		return err
+	}
+	if !params.IsAllowedDenom(types.WagerDenom(denom)) {
+		return sdkerrors.Wrapf(types.ErrDenomNotAllowed, "%s", types.WagerDenom(denom))
+	}
+	if timeControl.IsZero() {
+		timeControl = types.TimeControl{TurnDuration: params.MaxTurnDuration}
+	} else if err := timeControl.CheckBounds(params.MinTimeControl, params.MaxTimeControl); err != nil {
+		return err
+	}
+	newGame := variant.New()
+	// Games set up from a position keep it, normalized, so that they can be replayed.
+	fen := ""
+	if fenString != "" {
+		fenGame, err := types.ParseStartFen(variant, fenString)
+		if err != nil {
+			return err
+		}
+		newGame = fenGame
+		fen = newGame.Fen()
+	}
 
 	storedGame := types.StoredGame{
 		Index:       newIndex, // using the new index from system info here.
 		Board:       newGame.String(),
 		Turn:        rules.PieceStrings[newGame.Turn],
-		Black:       msg.Black, // these come from the command line message. or grpc
-		Red:         msg.Red,
+		Black:       black, // these come from the command line message. or grpc
+		Red:         red,
 		MoveCount:   0,
-		BeforeIndex: types.NoFifoIndex,
-		AfterIndex:  types.NoFifoIndex,
-		Deadline:    types.FormatDeadline(types.GetNextDeadline(ctx)),
 		Winner:      rules.PieceStrings[rules.NO_PLAYER],
-		Wager:       msg.Wager,
+		Wager:       wager,
+		Variant:     variantName,
+		Fen:         fen,
+		Denom:       denom,
+		Status:      types.GameStatusPending,
+		Creator:     creator,
+		TimeControl: timeControl,
+		BlackClock:  timeControl.Clock,
+		RedClock:    timeControl.Clock,
 	}
+	storedGame.Deadline = types.FormatDeadline(storedGame.GetNextDeadline(ctx))
 
 	// Confirm that the values in the object are correct by checking the validity of the players
 	// addresses:
 
 	err := storedGame.Validate()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	// Send the stored game to the tail.(because it is the most recent now)
-	k.Keeper.SendToFifoTail(ctx, &storedGame, &systemInfo)
+	if creator == black {
+		if err := k.CollectWager(ctx, &storedGame, rules.PieceStrings[rules.BLACK_PLAYER]); err != nil {
+			return err
+		}
+	}
+	if creator == red {
+		if err := k.CollectWager(ctx, &storedGame, rules.PieceStrings[rules.RED_PLAYER]); err != nil {
+			return err
+		}
+	}
+	if opponentPaid || black == red {
+		storedGame.Status = types.GameStatusActive
+	}
+	k.saveNewGame(ctx, storedGame)
+	return nil
+}
 
+// Creates and saves a tournament game at the given index, the caller is left to save the system
+// info. Tournament games are played with American rules and without a wager, so they start active,
+// each move limited to the max turn duration param. Unlike the games players create, they do not
+// depend on the denoms and time controls the params allow, as a round is paired whatever these
+// became since the tournament was created.
+func (k Keeper) createTournamentGame(ctx sdk.Context, newIndex string, tournamentIndex string, black string, red string) error {
+	newGame := rules.New()
+	timeControl := types.TimeControl{TurnDuration: k.GetParams(ctx).MaxTurnDuration}
+	storedGame := types.StoredGame{
+		Index:           newIndex,
+		Board:           newGame.String(),
+		Turn:            rules.PieceStrings[newGame.Turn],
+		Black:           black,
+		Red:             red,
+		Winner:          rules.PieceStrings[rules.NO_PLAYER],
+		Status:          types.GameStatusActive,
+		Creator:         black,
+		TimeControl:     timeControl,
+		TournamentIndex: tournamentIndex,
+	}
+	storedGame.Deadline = types.FormatDeadline(storedGame.GetNextDeadline(ctx))
+	if err := storedGame.Validate(); err != nil {
+		return err
+	}
+	k.saveNewGame(ctx, storedGame)
+	return nil
+}
+
+// Saves a game just created, charging its gas and emitting its creation event.
+func (k Keeper) saveNewGame(ctx sdk.Context, storedGame types.StoredGame) {
 	//Save the storedGame object using the Keeper.SetStoredGame function created by the
 	// ignite scaffold map storedGame command.
-	k.Keeper.SetStoredGame(ctx, storedGame)
-
-	// Prepare the ground work for the next game using Keeper.SetSystemInfo function
-	// created by Ignite CLI
-	systemInfo.NextId++
-	k.Keeper.SetSystemInfo(ctx, systemInfo)
+	k.SetStoredGame(ctx, storedGame)
 
 	// Consume the gas for creating the game.
-	ctx.GasMeter().ConsumeGas(types.CreateGameGas, "Create game")
+	ctx.GasMeter().ConsumeGas(k.CreateGameGas(ctx), "Create game")
 
 	// Emit the events when the game is created
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(types.GameCreatedEventType,
-			sdk.NewAttribute(types.GameCreatedEventCreator, msg.Creator),
-			sdk.NewAttribute(types.GameCreatedEventGameIndex, newIndex),
-			sdk.NewAttribute(types.GameCreatedEventBlack, msg.Black),
-			sdk.NewAttribute(types.GameCreatedEventRed, msg.Red),
-			sdk.NewAttribute(types.GameCreatedEventWager, strconv.FormatUint(msg.Wager, 10)),
+			sdk.NewAttribute(types.GameCreatedEventCreator, storedGame.Creator),
+			sdk.NewAttribute(types.GameCreatedEventGameIndex, storedGame.Index),
+			sdk.NewAttribute(types.GameCreatedEventBlack, storedGame.Black),
+			sdk.NewAttribute(types.GameCreatedEventRed, storedGame.Red),
+			sdk.NewAttribute(types.GameCreatedEventWager, strconv.FormatUint(storedGame.Wager, 10)),
 		),
 	)
-	// Return the newley creatd id for reference.
-	return &types.MsgCreateGameResponse{
-		GameIndex: newIndex,
-	}, nil
 }
@@ -3,29 +3,38 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/alice/checkers/rules"
 	keepertest "github.com/alice/checkers/testutil/keeper"
 	"github.com/alice/checkers/x/checkers"
 	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/testutil"
 	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
 // This initialises the genesis properly so there is a next id to read from.
+// Creating a game escrows the creator's wager, which the bank mock lets through.
 func setupMsgServerCreateGame(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context) {
-	k, ctx := keepertest.CheckersKeeper(t)
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
 	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
-	return keeper.NewMsgServerImpl(*k), *k, sdk.WrapSDKContext(ctx)
+	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectAny(context)
+	return keeper.NewMsgServerImpl(*k), *k, context
 }
 
 func TestCreateGame(t *testing.T) {
 	msgServer, _, context := setupMsgServerCreateGame(t)
 	createResponse, err := msgServer.CreateGame(context, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	require.Nil(t, err)
 	require.EqualValues(t, types.MsgCreateGameResponse{
@@ -46,19 +55,17 @@ func TestCreate1GameHasSaved(t *testing.T) {
 
 	// creat hte game
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	//check if the system info has been created
 	systemInfo, found := keeper.GetSystemInfo(sdk.UnwrapSDKContext(context))
 	require.True(t, found)
 	// check if the next id has been creatd
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId: 2,
 	}, systemInfo)
 
 	// get the stored game then check if it
@@ -71,10 +78,13 @@ func TestCreate1GameHasSaved(t *testing.T) {
 		Black:       bob,
 		Red:         carol,
 		MoveCount:   0,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
+		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl: types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
 		Wager:       45,
+		Winner:      "*",
+		Denom:       "stake",
+		Status:      types.GameStatusPending,
+		Creator:     bob,
 	}, game1)
 }
 
@@ -82,10 +92,10 @@ func TestCreate1GameGetAll(t *testing.T) {
 	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	games := keeper.GetAllStoredGame(sdk.UnwrapSDKContext(context))
 	require.Len(t, games, 1)
@@ -96,10 +106,13 @@ func TestCreate1GameGetAll(t *testing.T) {
 		Black:       bob,
 		Red:         carol,
 		MoveCount:   0,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
+		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl: types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
 		Wager:       45,
+		Winner:      "*",
+		Denom:       "stake",
+		Status:      types.GameStatusPending,
+		Creator:     bob,
 	}, games[0])
 }
 
@@ -107,10 +120,10 @@ func TestCreate1GameEmitted(t *testing.T) {
 	//sets up a new game context next ide etc. then creaqts a game
 	msgSrvr, _, context := setupMsgServerCreateGame(t)
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	ctx := sdk.UnwrapSDKContext(context)
 	// makes sure the context is not nil
@@ -123,7 +136,7 @@ func TestCreate1GameEmitted(t *testing.T) {
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "new-game-created",
 		Attributes: []sdk.Attribute{
-			{Key: "creator", Value: alice},
+			{Key: "creator", Value: bob},
 			{Key: "game-index", Value: "1"},
 			{Key: "black", Value: bob},
 			{Key: "red", Value: carol},
@@ -135,10 +148,10 @@ func TestCreate1GameEmitted(t *testing.T) {
 func TestCreateGameRedAddressBad(t *testing.T) {
 	msgServer, _, context := setupMsgServerCreateGame(t)
 	createResponse, err := msgServer.CreateGame(context, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     "notanaddress",
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	require.Nil(t, createResponse)
 	require.Equal(t,
@@ -149,10 +162,10 @@ func TestCreateGameRedAddressBad(t *testing.T) {
 func TestCreateGameEmptyRedAddress(t *testing.T) {
 	msgServer, _, context := setupMsgServerCreateGame(t)
 	createResponse, err := msgServer.CreateGame(context, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     "",
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	require.Nil(t, createResponse)
 	require.Equal(t,
@@ -163,26 +176,26 @@ func TestCreateGameEmptyRedAddress(t *testing.T) {
 func TestCreate3Games(t *testing.T) {
 	msgSrvr, _, context := setupMsgServerCreateGame(t)
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	createResponse2, err2 := msgSrvr.CreateGame(context, &types.MsgCreateGame{
-		Creator: bob,
+		Creator: carol,
 		Black:   carol,
 		Red:     alice,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	require.Nil(t, err2)
 	require.EqualValues(t, types.MsgCreateGameResponse{
 		GameIndex: "2",
 	}, *createResponse2)
 	createResponse3, err3 := msgSrvr.CreateGame(context, &types.MsgCreateGame{
-		Creator: carol,
+		Creator: alice,
 		Black:   alice,
 		Red:     bob,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	require.Nil(t, err3)
 	require.EqualValues(t, types.MsgCreateGameResponse{
@@ -194,29 +207,27 @@ func TestCreate3GamesHasSaved(t *testing.T) {
 	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
-		Creator: bob,
+		Creator: carol,
 		Black:   carol,
 		Red:     alice,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
-		Creator: carol,
+		Creator: alice,
 		Black:   alice,
 		Red:     bob,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        4,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "3",
+		NextId: 4,
 	}, systemInfo)
 	game1, found1 := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found1)
@@ -227,10 +238,13 @@ func TestCreate3GamesHasSaved(t *testing.T) {
 		Black:       bob,
 		Red:         carol,
 		MoveCount:   0,
-		BeforeIndex: "-1",
-		AfterIndex:  "2",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
+		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl: types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
 		Wager:       45,
+		Winner:      "*",
+		Denom:       "stake",
+		Status:      types.GameStatusPending,
+		Creator:     bob,
 	}, game1)
 	game2, found2 := keeper.GetStoredGame(ctx, "2")
 	require.True(t, found2)
@@ -241,10 +255,13 @@ func TestCreate3GamesHasSaved(t *testing.T) {
 		Black:       carol,
 		Red:         alice,
 		MoveCount:   0,
-		BeforeIndex: "1",
-		AfterIndex:  "3",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
+		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl: types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
 		Wager:       45,
+		Winner:      "*",
+		Denom:       "stake",
+		Status:      types.GameStatusPending,
+		Creator:     carol,
 	}, game2)
 	game3, found3 := keeper.GetStoredGame(ctx, "3")
 	require.True(t, found3)
@@ -255,10 +272,13 @@ func TestCreate3GamesHasSaved(t *testing.T) {
 		Black:       alice,
 		Red:         bob,
 		MoveCount:   0,
-		BeforeIndex: "2",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
+		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl: types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
 		Wager:       45,
+		Winner:      "*",
+		Denom:       "stake",
+		Status:      types.GameStatusPending,
+		Creator:     alice,
 	}, game3)
 }
 
@@ -266,22 +286,22 @@ func TestCreate3GamesGetAll(t *testing.T) {
 	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
-		Creator: bob,
+		Creator: carol,
 		Black:   carol,
 		Red:     alice,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
-		Creator: carol,
+		Creator: alice,
 		Black:   alice,
 		Red:     bob,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	games := keeper.GetAllStoredGame(sdk.UnwrapSDKContext(context))
 	require.Len(t, games, 3)
@@ -292,10 +312,13 @@ func TestCreate3GamesGetAll(t *testing.T) {
 		Black:       bob,
 		Red:         carol,
 		MoveCount:   0,
-		BeforeIndex: "-1",
-		AfterIndex:  "2",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
+		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl: types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
 		Wager:       45,
+		Winner:      "*",
+		Denom:       "stake",
+		Status:      types.GameStatusPending,
+		Creator:     bob,
 	}, games[0])
 	require.EqualValues(t, types.StoredGame{
 		Index:       "2",
@@ -304,10 +327,13 @@ func TestCreate3GamesGetAll(t *testing.T) {
 		Black:       carol,
 		Red:         alice,
 		MoveCount:   0,
-		BeforeIndex: "1",
-		AfterIndex:  "3",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
+		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl: types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
 		Wager:       45,
+		Winner:      "*",
+		Denom:       "stake",
+		Status:      types.GameStatusPending,
+		Creator:     carol,
 	}, games[1])
 	require.EqualValues(t, types.StoredGame{
 		Index:       "3",
@@ -316,10 +342,13 @@ func TestCreate3GamesGetAll(t *testing.T) {
 		Black:       alice,
 		Red:         bob,
 		MoveCount:   0,
-		BeforeIndex: "2",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
+		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl: types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
 		Wager:       45,
+		Winner:      "*",
+		Denom:       "stake",
+		Status:      types.GameStatusPending,
+		Creator:     alice,
 	}, games[2])
 }
 
@@ -332,10 +361,10 @@ func TestCreateGameFarFuture(t *testing.T) {
 	keeper.SetSystemInfo(ctx, systemInfo)
 
 	createResponse, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 
 	require.Nil(t, err)
@@ -349,9 +378,7 @@ func TestCreateGameFarFuture(t *testing.T) {
 	require.True(t, found)
 
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        1025,
-		FifoHeadIndex: "1024",
-		FifoTailIndex: "1024",
+		NextId: 1025,
 	}, systemInfo)
 }
 
@@ -359,10 +386,10 @@ func TestSavedCreatedDeadlineIsParseable(t *testing.T) {
 	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	game, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
@@ -375,11 +402,269 @@ func TestCreate1GameConsumedGas(t *testing.T) {
 	ctx := sdk.UnwrapSDKContext(context)
 	before := ctx.GasMeter().GasConsumed()
 	msgSrvr.CreateGame(context, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	after := ctx.GasMeter().GasConsumed()
 	require.GreaterOrEqual(t, after, before+25_000)
 }
+
+func TestCreateInternationalGameHasSaved(t *testing.T) {
+	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	_, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+		Variant: "international",
+	})
+	require.Nil(t, err)
+
+	game1, found1 := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found1)
+	require.EqualValues(t, types.StoredGame{
+		Index:       "1",
+		Board:       "*b*b*b*b*b|b*b*b*b*b*|*b*b*b*b*b|b*b*b*b*b*|**********|**********|*r*r*r*r*r|r*r*r*r*r*|*r*r*r*r*r|r*r*r*r*r*",
+		Turn:        "r",
+		Black:       bob,
+		Red:         carol,
+		MoveCount:   0,
+		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl: types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
+		Wager:       45,
+		Winner:      "*",
+		Variant:     "international",
+		Denom:       "stake",
+		Status:      types.GameStatusPending,
+		Creator:     bob,
+	}, game1)
+}
+
+func TestCreateGameUnknownVariant(t *testing.T) {
+	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
+	createResponse, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+		Variant: "chess",
+	})
+	require.Nil(t, createResponse)
+	require.Equal(t, "chess: unknown rules variant: %s", err.Error())
+	_, found := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.False(t, found)
+}
+
+func TestCreateGameInAllowedDenomHasSaved(t *testing.T) {
+	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	keeper.SetParams(ctx, types.NewParams([]string{"stake", "ibc/ATOM"}, types.DefaultMaxTurnDuration, types.DefaultCreateGameGas, types.DefaultPlayMoveGas, types.DefaultRejectGameRefundGas, types.DefaultMinTimeControl, types.DefaultMaxTimeControl, types.DefaultLeaderboardLength, types.DefaultLeaderboardRefreshInterval, types.DefaultMaxTakebacks, types.DefaultMaxMovesWithoutProgress, types.DefaultMaxChallengeDuration, types.DefaultMaxForfeitsPerBlock, types.DefaultMaxChallengeExpiriesPerBlock))
+	_, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("ibc/ATOM", 45),
+	})
+	require.Nil(t, err)
+
+	game1, found1 := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found1)
+	require.Equal(t, "ibc/ATOM", game1.Denom)
+	require.Equal(t, sdk.NewInt64Coin("ibc/ATOM", 45), game1.GetWagerCoin())
+}
+
+func TestCreateGameDenomNotAllowed(t *testing.T) {
+	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
+	createResponse, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("ibc/ATOM", 45),
+	})
+	require.Nil(t, createResponse)
+	require.Equal(t, "ibc/ATOM: denomination not allowed for wagers: %s", err.Error())
+	_, found := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.False(t, found)
+}
+
+func TestCreateGameFromFenHasSaved(t *testing.T) {
+	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	_, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+		Fen:     "W:B9,5:WK30,21",
+	})
+	require.Nil(t, err)
+
+	game1, found1 := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found1)
+	require.EqualValues(t, types.StoredGame{
+		Index:       "1",
+		Board:       "********|b*******|*b******|********|********|r*******|********|**R*****",
+		Turn:        "r",
+		Black:       bob,
+		Red:         carol,
+		MoveCount:   0,
+		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl: types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
+		Wager:       45,
+		Winner:      "*",
+		Fen:         "W:W21,K30:B5,9",
+		Denom:       "stake",
+		Status:      types.GameStatusPending,
+		Creator:     bob,
+	}, game1)
+}
+
+func TestCreateGameInitialFenIsInitialBoard(t *testing.T) {
+	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	_, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+		Fen:     "B:W21-32:B1-12",
+	})
+	require.Nil(t, err)
+
+	game1, _ := keeper.GetStoredGame(ctx, "1")
+	require.Equal(t, rules.New().String(), game1.Board)
+	require.Equal(t, "b", game1.Turn)
+	require.Equal(t, "B:W21,22,23,24,25,26,27,28,29,30,31,32:B1,2,3,4,5,6,7,8,9,10,11,12", game1.Fen)
+	require.Equal(t, rules.New().Fen(), game1.Fen)
+}
+
+func TestCreateGameFromBlockedFen(t *testing.T) {
+	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
+	createResponse, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+		Fen:     "B:W8,11:B4",
+	})
+	require.Nil(t, createResponse)
+	require.Equal(t, "black has no move left: invalid starting position: %s", err.Error())
+	_, found := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.False(t, found)
+}
+
+func TestCreateGameDeadlineFromParams(t *testing.T) {
+	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := types.DefaultParams()
+	params.MaxTurnDuration = time.Hour
+	keeper.SetParams(ctx, params)
+	msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+	})
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime().Add(time.Hour)), game.Deadline)
+}
+
+func TestCreateGameConsumesGasFromParams(t *testing.T) {
+	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := types.DefaultParams()
+	params.CreateGameGas = 1_000_000
+	keeper.SetParams(ctx, params)
+	before := ctx.GasMeter().GasConsumed()
+	msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+	})
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), before+1_000_000)
+}
+
+func TestCreateGameWithClockHasSaved(t *testing.T) {
+	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator:     bob,
+		Black:       bob,
+		Red:         carol,
+		Wager:       sdk.NewInt64Coin("stake", 45),
+		TimeControl: types.TimeControl{Clock: 10 * time.Minute, Increment: 5 * time.Second},
+	})
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.TimeControl{Clock: 10 * time.Minute, Increment: 5 * time.Second}, game.TimeControl)
+	require.Equal(t, 10*time.Minute, game.BlackClock)
+	require.Equal(t, 10*time.Minute, game.RedClock)
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime().Add(10*time.Minute)), game.Deadline)
+}
+
+func TestCreateGameWithTurnDurationHasSaved(t *testing.T) {
+	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator:     bob,
+		Black:       bob,
+		Red:         carol,
+		Wager:       sdk.NewInt64Coin("stake", 45),
+		TimeControl: types.TimeControl{TurnDuration: 30 * time.Second},
+	})
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, types.TimeControl{TurnDuration: 30 * time.Second}, game.TimeControl)
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime().Add(30*time.Second)), game.Deadline)
+}
+
+// A param change proposal validates each param on its own, so the bounds can end up inconsistent.
+func TestCreateGameInconsistentTimeParams(t *testing.T) {
+	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := types.DefaultParams()
+	params.MinTimeControl = time.Hour
+	keeper.SetParams(ctx, params)
+	createResponse, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+	})
+	require.Nil(t, createResponse)
+	require.Equal(t, "max turn duration 5m0s not within time control bounds 1h0m0s and 168h0m0s: time control params are inconsistent", err.Error())
+
+	params.MaxTimeControl = time.Minute
+	keeper.SetParams(ctx, params)
+	createResponse, err = msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator:     bob,
+		Black:       bob,
+		Red:         carol,
+		Wager:       sdk.NewInt64Coin("stake", 45),
+		TimeControl: types.TimeControl{TurnDuration: time.Minute},
+	})
+	require.Nil(t, createResponse)
+	require.Equal(t, "min time control 1h0m0s above max time control 1m0s: time control params are inconsistent", err.Error())
+	_, found := keeper.GetStoredGame(ctx, "1")
+	require.False(t, found)
+}
+
+func TestCreateGameTimeControlOutOfBounds(t *testing.T) {
+	msgSrvr, keeper, context := setupMsgServerCreateGame(t)
+	createResponse, err := msgSrvr.CreateGame(context, &types.MsgCreateGame{
+		Creator:     bob,
+		Black:       bob,
+		Red:         carol,
+		Wager:       sdk.NewInt64Coin("stake", 45),
+		TimeControl: types.TimeControl{TurnDuration: time.Second},
+	})
+	require.Nil(t, createResponse)
+	require.Equal(t, "1s not within 30s and 168h0m0s: time control out of bounds", err.Error())
+	_, found := keeper.GetStoredGame(sdk.UnwrapSDKContext(context), "1")
+	require.False(t, found)
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) CreateTournament(goCtx context.Context, msg *types.MsgCreateTournament) (*types.MsgCreateTournamentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.Keeper.GetParams(ctx).IsAllowedDenom(types.WagerDenom(msg.Denom)) {
+		return nil, sdkerrors.Wrapf(types.ErrDenomNotAllowed, "%s", types.WagerDenom(msg.Denom))
+	}
+
+	tournament := types.Tournament{
+		Creator:    msg.Creator,
+		Format:     msg.Format,
+		EntryFee:   msg.EntryFee,
+		Denom:      msg.Denom,
+		MaxPlayers: msg.MaxPlayers,
+		StartTime:  msg.StartTime,
+		Status:     types.TournamentStatusOpen,
+	}
+	startTime, err := tournament.GetStartTimeAsTime()
+	if err != nil {
+		return nil, err
+	}
+	if !ctx.BlockTime().Before(startTime) {
+		return nil, sdkerrors.Wrapf(types.ErrStartTimePassed, "%s", msg.StartTime)
+	}
+	// Stored in the same layout as deadlines, whatever the time zone it was given in.
+	tournament.StartTime = types.FormatDeadline(startTime)
+
+	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+
+	// As with challenges, tournaments take the next game index.
+	newIndex := strconv.FormatUint(systemInfo.NextId, 10)
+	tournament.Index = newIndex
+	k.Keeper.SetTournament(ctx, tournament)
+
+	systemInfo.NextId++
+	k.Keeper.SetSystemInfo(ctx, systemInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.TournamentCreatedEventType,
+			sdk.NewAttribute(types.TournamentCreatedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.TournamentCreatedEventTournamentIndex, newIndex),
+			sdk.NewAttribute(types.TournamentCreatedEventFormat, msg.Format),
+			sdk.NewAttribute(types.TournamentCreatedEventEntryFee, strconv.FormatUint(msg.EntryFee, 10)),
+			sdk.NewAttribute(types.TournamentCreatedEventStartTime, tournament.StartTime),
+		),
+	)
+
+	return &types.MsgCreateTournamentResponse{
+		TournamentIndex: newIndex,
+	}, nil
+}
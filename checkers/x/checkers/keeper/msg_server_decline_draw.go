@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) DeclineDraw(goCtx context.Context, msg *types.MsgDeclineDraw) (*types.MsgDeclineDrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, err := k.Keeper.getDrawOfferToAnswer(ctx, msg.GameIndex, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	// The game carries on as if nothing was offered.
+	storedGame.DrawOffer = ""
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.DrawDeclinedEventType,
+			sdk.NewAttribute(types.DrawDeclinedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.DrawDeclinedEventGameIndex, msg.GameIndex),
+		),
+	)
+
+	return &types.MsgDeclineDrawResponse{}, nil
+}
@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeclineDrawSaved(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	declineDrawResponse, err := msgServer.DeclineDraw(context, &types.MsgDeclineDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgDeclineDrawResponse{}, *declineDrawResponse)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "", game.DrawOffer)
+	require.Equal(t, "*", game.Winner)
+}
+
+func TestDeclineDrawEmitted(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	msgServer.DeclineDraw(context, &types.MsgDeclineDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 4)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "draw-declined",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: carol},
+			{Key: "game-index", Value: "1"},
+		},
+	}, events[0])
+}
+
+func TestDeclineDrawOwnOffer(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	declineDrawResponse, err := msgServer.DeclineDraw(context, &types.MsgDeclineDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, declineDrawResponse)
+	require.Equal(t, "player cannot answer their own draw offer", err.Error())
+}
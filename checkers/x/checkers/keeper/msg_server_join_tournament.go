@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) JoinTournament(goCtx context.Context, msg *types.MsgJoinTournament) (*types.MsgJoinTournamentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tournament, found := k.Keeper.GetTournament(ctx, msg.TournamentIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrTournamentNotFound, "%s", msg.TournamentIndex)
+	}
+	if tournament.Status != types.TournamentStatusOpen {
+		return nil, types.ErrTournamentNotOpen
+	}
+	if tournament.HasPlayer(msg.Creator) {
+		return nil, types.ErrAlreadyJoined
+	}
+	if tournament.MaxPlayers <= uint64(len(tournament.Players)) {
+		return nil, types.ErrTournamentFull
+	}
+
+	// The entry fee waits in escrow for the prize, or for the tournament to be cancelled.
+	if err := k.Keeper.CollectEntryFee(ctx, &tournament, msg.Creator); err != nil {
+		return nil, err
+	}
+	tournament.Players = append(tournament.Players, msg.Creator)
+	k.Keeper.SetTournament(ctx, tournament)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.TournamentJoinedEventType,
+			sdk.NewAttribute(types.TournamentJoinedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.TournamentJoinedEventTournamentIndex, msg.TournamentIndex),
+		),
+	)
+
+	return &types.MsgJoinTournamentResponse{}, nil
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) OfferDraw(goCtx context.Context, msg *types.MsgOfferDraw) (*types.MsgOfferDrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil, types.ErrGameFinished
+	}
+	if storedGame.Status == types.GameStatusPending {
+		return nil, types.ErrGameNotAccepted
+	}
+
+	isBlack := storedGame.Black == msg.Creator
+	isRed := storedGame.Red == msg.Creator
+	var offerer string
+	if !isBlack && !isRed {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	} else if isBlack && isRed {
+		offerer = storedGame.Turn
+	} else if isBlack {
+		offerer = rules.PieceStrings[rules.BLACK_PLAYER]
+	} else {
+		offerer = rules.PieceStrings[rules.RED_PLAYER]
+	}
+
+	// Only one offer can be pending at a time, the other player has to answer it first.
+	if storedGame.DrawOffer != "" {
+		return nil, sdkerrors.Wrapf(types.ErrDrawAlreadyOffered, "%s", storedGame.DrawOffer)
+	}
+
+	storedGame.DrawOffer = offerer
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.DrawOfferedEventType,
+			sdk.NewAttribute(types.DrawOfferedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.DrawOfferedEventGameIndex, msg.GameIndex),
+		),
+	)
+
+	return &types.MsgOfferDrawResponse{}, nil
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/checkers"
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/testutil"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerWithOneGameForDraw(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	payBob := bankMock.ExpectPay(context, bob, 45)
+	bankMock.ExpectPay(context, carol, 45).After(payBob)
+	server.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+	})
+	server.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	return server, *k, context, ctrl, bankMock
+}
+
+func TestOfferDrawSaved(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	offerDrawResponse, err := msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgOfferDrawResponse{}, *offerDrawResponse)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "r", game.DrawOffer)
+	require.Equal(t, "*", game.Winner)
+}
+
+func TestOfferDrawEmitted(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 3)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "draw-offered",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: bob},
+			{Key: "game-index", Value: "1"},
+		},
+	}, events[0])
+}
+
+func TestOfferDrawByNonPlayer(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	offerDrawResponse, err := msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   alice,
+		GameIndex: "1",
+	})
+	require.Nil(t, offerDrawResponse)
+	require.Equal(t, alice+": message creator is not a player", err.Error())
+}
+
+func TestOfferDrawTwice(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	offerDrawResponse, err := msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, offerDrawResponse)
+	require.Equal(t, "b: a draw offer is already pending", err.Error())
+}
+
+func TestOfferDrawClearedByOpponentMove(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "", game.DrawOffer)
+}
+
+func TestOfferDrawKeptByOwnMove(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "b", game.DrawOffer)
+}
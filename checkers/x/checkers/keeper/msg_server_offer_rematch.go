@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) OfferRematch(goCtx context.Context, msg *types.MsgOfferRematch) (*types.MsgOfferRematchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+	if storedGame.Status != types.GameStatusFinished {
+		return nil, types.ErrRematchGameNotFinished
+	}
+
+	isBlack := storedGame.Black == msg.Creator
+	isRed := storedGame.Red == msg.Creator
+	var offerer string
+	if !isBlack && !isRed {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	} else if isBlack {
+		offerer = rules.PieceStrings[rules.BLACK_PLAYER]
+	} else {
+		offerer = rules.PieceStrings[rules.RED_PLAYER]
+	}
+
+	// A game is played again once at most, further rematches are offered from the rematch itself.
+	if storedGame.RematchGame != "" {
+		return nil, sdkerrors.Wrapf(types.ErrRematchAlreadyPlayed, "%s", storedGame.RematchGame)
+	}
+	if storedGame.RematchOffer != "" {
+		return nil, sdkerrors.Wrapf(types.ErrRematchAlreadyOffered, "%s", storedGame.RematchOffer)
+	}
+
+	storedGame.RematchOffer = offerer
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.RematchOfferedEventType,
+			sdk.NewAttribute(types.RematchOfferedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.RematchOfferedEventGameIndex, msg.GameIndex),
+		),
+	)
+
+	return &types.MsgOfferRematchResponse{}, nil
+}
@@ -14,26 +14,61 @@ import (
 func (k msgServer) PlayMove(goCtx context.Context, msg *types.MsgPlayMove) (*types.MsgPlayMoveResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// TODO: Handling the message
+	captured, winner, err := k.playAlong(ctx, msg.Creator, msg.GameIndex, []rules.Pos{
+		{
+			X: int(msg.FromX),
+			Y: int(msg.FromY),
+		},
+		{
+			X: int(msg.ToX),
+			Y: int(msg.ToY),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Return relevant information regarding the move's result:
+	capturedPos := rules.NO_POS
+	if len(captured) > 0 {
+		capturedPos = captured[0]
+	}
+	return &types.MsgPlayMoveResponse{
+		CapturedX: int32(capturedPos.X),
+		CapturedY: int32(capturedPos.Y),
+		Winner:    winner,
+	}, nil
+
+	// The captured and winner information would be lost if you did not get it out of the function
+	// More accurately, one would have to replay the transaction to discover the values. It is best
+	// to make this information easily accessible.
+}
+
+// Plays the creator's piece along the path, one hop after the other, and saves the outcome.
+func (k msgServer) playAlong(ctx sdk.Context, creator string, gameIndex string, path []rules.Pos) (captured []rules.Pos, winner string, err error) {
 	// get the stored game information from the keeper
-	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	storedGame, found := k.Keeper.GetStoredGame(ctx, gameIndex)
 	// you return an error since this is a player mistake
 	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+		return nil, "", sdkerrors.Wrapf(types.ErrGameNotFound, "%s", gameIndex)
 	}
 
 	// if the stored game winner is not a no player means that the game has ended. with a winner.
 	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
-		return nil, types.ErrGameFinished
+		return nil, "", types.ErrGameFinished
+	}
+	// Nobody moves before the opponent has accepted the game and escrowed their stake.
+	if storedGame.Status == types.GameStatusPending {
+		return nil, "", types.ErrGameNotAccepted
 	}
 
 	// WHat this is doing is checking if the player is legitimate in this game to play
 	// if they are black or red they will be the same
-	isBlack := storedGame.Black == msg.Creator
-	isRed := storedGame.Red == msg.Creator
+	isBlack := storedGame.Black == creator
+	isRed := storedGame.Red == creator
 	var player rules.Player
 	if !isBlack && !isRed {
-		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+		return nil, "", sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", creator)
 	} else if isBlack && isRed { // i dont understand this it sets the player to the player who's turn it is??
 		player = rules.StringPieces[storedGame.Turn].Player
 	} else if isBlack { // if the player is black then player is set to black.
@@ -53,92 +88,117 @@ func (k msgServer) PlayMove(goCtx context.Context, msg *types.MsgPlayMove) (*typ
 	// Is it the players turn? Check using the rules file's own TurnIs function:
 
 	if !game.TurnIs(player) {
-		return nil, sdkerrors.Wrapf(types.ErrNotPlayerTurn, "%s", player)
-	}
-
-	// Collect the wager from the player.
-	err = k.Keeper.CollectWager(ctx, &storedGame)
-	if err != nil {
-		return nil, err
+		return nil, "", sdkerrors.Wrapf(types.ErrNotPlayerTurn, "%s", player)
 	}
 
-	// Properly conduct the move using the rules move function:
+	// Properly conduct the moves using the rules, none of them is kept if one fails:
 
-	captured, moveErr := game.Move(
-		rules.Pos{
-			X: int(msg.FromX),
-			Y: int(msg.FromY),
-		},
-		rules.Pos{
-			X: int(msg.ToX),
-			Y: int(msg.ToY),
-		},
-	)
+	captured, moveErr := game.MoveAlong(path)
 
 	// if the move is an error state it so.
 	if moveErr != nil {
-		return nil, sdkerrors.Wrapf(types.ErrWrongMove, moveErr.Error())
+		return nil, "", sdkerrors.Wrapf(types.ErrWrongMove, moveErr.Error())
+	}
+
+	// Once the whole path is known to be valid, replay it hop by hop for the move log.
+	gameMoves, err := gameMovesAlong(ctx, storedGame, path)
+	if err != nil {
+		panic(err.Error())
 	}
 
+	// Playing on instead of answering declines the opponent's pending draw offer.
+	if storedGame.DrawOffer != rules.PieceStrings[player] {
+		storedGame.DrawOffer = ""
+	}
+	// A takeback request no longer fits the game once a move is played, whoever plays it.
+	storedGame.TakebackRequest = ""
+	storedGame.TakebackPlies = 0
+
 	// Update the winner field, which remains neutral if there is no winner yet:
-	storedGame.Winner = rules.PieceStrings[game.Winner()]
+	storedGame.Winner = rules.PieceStrings[game.Status()]
+	// Repeating positions or shuffling kings for too long ends the game as a draw:
+	if game.IsDraw(int(k.Keeper.MaxMovesWithoutProgress(ctx))) {
+		storedGame.Winner = rules.PieceStrings[rules.DRAW_PLAYER]
+	}
 
 	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
 	if !found {
 		panic("SystemInfo not found")
 	}
 
+	// The time spent is taken off the mover's clock before the turn passes, and the next
+	// deadline has to be known to keep the game's place in the deadline index.
+	if err := storedGame.StopClock(ctx, game.Turn != player); err != nil {
+		panic(err.Error())
+	}
+	storedGame.Turn = rules.PieceStrings[game.Turn]
+	storedGame.CapturingSquare = types.CapturingSquare(game)
+	storedGame.CapturedSquares = types.CapturedSquares(game)
+	storedGame.Deadline = types.FormatDeadline(storedGame.GetNextDeadline(ctx))
+
 	lastBoard := game.String()
+	// The final board is kept, so that finished games still show how they ended.
+	storedGame.Board = lastBoard
 	if storedGame.Winner == rules.PieceStrings[rules.NO_PLAYER] {
-		k.Keeper.SendToFifoTail(ctx, &storedGame, &systemInfo)
-		storedGame.Board = lastBoard
+		storedGame.PositionHistory = game.History
+		storedGame.MovesWithoutProgress = uint64(game.MovesWithoutProgress)
 	} else {
-		k.Keeper.RemoveFromFifo(ctx, &storedGame, &systemInfo)
-		storedGame.Board = ""
-		k.Keeper.MustPayWinnings(ctx, &storedGame)
+		storedGame.PositionHistory = nil
+		storedGame.MovesWithoutProgress = 0
+		storedGame.CapturingSquare = 0
+		storedGame.CapturedSquares = nil
+		if storedGame.Winner == rules.PieceStrings[rules.DRAW_PLAYER] {
+			k.Keeper.MustRefundWager(ctx, &storedGame)
+		} else {
+			k.Keeper.MustPayWinnings(ctx, &storedGame)
+		}
+		k.Keeper.MustRegisterGameResult(ctx, &storedGame, false)
+		if err := k.Keeper.RegisterTournamentResult(ctx, &storedGame, &systemInfo); err != nil {
+			return nil, "", err
+		}
+		storedGame.Status = types.GameStatusFinished
 	}
 
-	//k.Keeper.SendToFifoTail(ctx, &storedGame, &systemInfo)
-
-	// update the move count for the game.
-	storedGame.MoveCount++
-	storedGame.Deadline = types.FormatDeadline(types.GetNextDeadline(ctx))
+	// update the move count for the game, each hop counts as a move.
+	hops := uint64(len(path) - 1)
+	storedGame.MoveCount += hops
 	// Prepare the updated board to be stored and store the information:
 	//storedGame.Board = game.String()
-	storedGame.Turn = rules.PieceStrings[game.Turn]
-	// Sets the stored and system info that changed in the send to fifo tail section.
+	// Sets the stored game, and the system info the next tournament round may have changed.
 	k.Keeper.SetStoredGame(ctx, storedGame)
 	k.Keeper.SetSystemInfo(ctx, systemInfo)
+	for _, gameMove := range gameMoves {
+		k.Keeper.AppendGameMove(ctx, gameMove)
+	}
 
 	// Consume the gas for playing a move
-	ctx.GasMeter().ConsumeGas(types.PlayMoveGas, "Play a move")
+	ctx.GasMeter().ConsumeGas(k.Keeper.PlayMoveGas(ctx)*hops, "Play a move")
 
 	// This updates the fields that were modified using the Keeper.SetStoredGame
 	// Function, as when you created and saved the game.
 
 	// Emit the events
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(types.MovePlayedEventType,
-			sdk.NewAttribute(types.MovePlayedEventCreator, msg.Creator),
-			sdk.NewAttribute(types.MovePlayedEventGameIndex, msg.GameIndex),
-			sdk.NewAttribute(types.MovePlayedEventCapturedX, strconv.FormatInt(int64(captured.X), 10)),
-			sdk.NewAttribute(types.MovePlayedEventCapturedY, strconv.FormatInt(int64(captured.Y), 10)),
-			sdk.NewAttribute(types.MovePlayedEventWinner, rules.PieceStrings[game.Winner()]),
-			sdk.NewAttribute(types.MovePlayedEventBoard, lastBoard),
-		),
+	// There is a pair of captured coordinates for each capture, or a single -1 pair if there was none.
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.MovePlayedEventCreator, creator),
+		sdk.NewAttribute(types.MovePlayedEventGameIndex, gameIndex),
+	}
+	eventCaptured := captured
+	if len(eventCaptured) == 0 {
+		eventCaptured = []rules.Pos{rules.NO_POS}
+	}
+	for _, capturedPos := range eventCaptured {
+		attributes = append(attributes,
+			sdk.NewAttribute(types.MovePlayedEventCapturedX, strconv.FormatInt(int64(capturedPos.X), 10)),
+			sdk.NewAttribute(types.MovePlayedEventCapturedY, strconv.FormatInt(int64(capturedPos.Y), 10)),
+		)
+	}
+	attributes = append(attributes,
+		sdk.NewAttribute(types.MovePlayedEventWinner, storedGame.Winner),
+		sdk.NewAttribute(types.MovePlayedEventBoard, lastBoard),
 	)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.MovePlayedEventType, attributes...))
 
-	// Return relevant information regarding the move's result:
-
-	return &types.MsgPlayMoveResponse{
-		CapturedX: int32(captured.X),
-		CapturedY: int32(captured.Y),
-		Winner:    rules.PieceStrings[game.Winner()],
-	}, nil
-
-	// The captured and winner information would be lost if you did not get it out of the function
-	// More accurately, one would have to replay the transaction to discover the values. It is best
-	// to make this information easily accessible.
-
+	return captured, storedGame.Winner, nil
 }
@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/testutil"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// Only a black king and a red king are left, so nobody can win by capturing.
+const kingsOnlyBoard = "********|********|********|B*******|********|********|*******R|********"
+
+func setupMsgServerWithKingsOnlyGame(t testing.TB, movesWithoutProgress uint64) (types.MsgServer, keeper.Keeper, context.Context, *gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	msgServer, k, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Board = kingsOnlyBoard
+	storedGame.MoveCount = 2
+	storedGame.MovesWithoutProgress = movesWithoutProgress
+	k.SetStoredGame(ctx, storedGame)
+	return msgServer, k, context, ctrl, escrow
+}
+
+// Both kings go back and forth, so the position after black's first move comes back a third time.
+var kingsShuffleMoves = []*types.MsgPlayMove{
+	{Creator: bob, GameIndex: "1", FromX: 0, FromY: 3, ToX: 1, ToY: 2},
+	{Creator: carol, GameIndex: "1", FromX: 7, FromY: 6, ToX: 6, ToY: 7},
+	{Creator: bob, GameIndex: "1", FromX: 1, FromY: 2, ToX: 0, ToY: 3},
+	{Creator: carol, GameIndex: "1", FromX: 6, FromY: 7, ToX: 7, ToY: 6},
+}
+
+func TestPlayMoveRepeatedPositionIsDraw(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithKingsOnlyGame(t, 0)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+
+	for i := 0; i < 8; i++ {
+		response, err := msgServer.PlayMove(context, kingsShuffleMoves[i%4])
+		require.Nil(t, err)
+		require.Equal(t, "*", response.Winner)
+	}
+	response, err := msgServer.PlayMove(context, kingsShuffleMoves[0])
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgPlayMoveResponse{
+		CapturedX: -1,
+		CapturedY: -1,
+		Winner:    "d",
+	}, *response)
+
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId: 2,
+	}, systemInfo)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, types.StoredGame{
+		Index:       "1",
+		Board:       "********|********|*B******|********|********|********|*******R|********",
+		Turn:        "r",
+		Black:       bob,
+		Red:         carol,
+		MoveCount:   11,
+		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl: types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
+		Winner:      "d",
+		Wager:       45,
+		Denom:       "stake",
+		Status:      types.GameStatusFinished,
+		Creator:     bob,
+	}, game)
+}
+
+func TestPlayMoveRepeatedPositionHistorySaved(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithKingsOnlyGame(t, 0)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+
+	msgServer.PlayMove(context, kingsShuffleMoves[0])
+	msgServer.PlayMove(context, kingsShuffleMoves[1])
+
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, []string{
+		"********|********|*B******|********|********|********|*******R|********|r",
+		"********|********|*B******|********|********|********|********|******R*|b",
+	}, game.PositionHistory)
+	require.EqualValues(t, 2, game.MovesWithoutProgress)
+}
+
+func TestPlayMoveTooManyMovesWithoutProgressIsDraw(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithKingsOnlyGame(t, 2*types.DefaultMaxMovesWithoutProgress-2)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+
+	response, err := msgServer.PlayMove(context, kingsShuffleMoves[0])
+	require.Nil(t, err)
+	require.Equal(t, "*", response.Winner)
+	response, err = msgServer.PlayMove(context, kingsShuffleMoves[1])
+	require.Nil(t, err)
+	require.Equal(t, "d", response.Winner)
+}
+
+func TestPlayMoveMaxMovesWithoutProgressFromParams(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithKingsOnlyGame(t, 19)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := keeper.GetParams(ctx)
+	params.MaxMovesWithoutProgress = 10
+	keeper.SetParams(ctx, params)
+
+	response, err := msgServer.PlayMove(context, kingsShuffleMoves[0])
+	require.Nil(t, err)
+	require.Equal(t, "d", response.Winner)
+}
+
+func TestPlayMoveDrawEmitted(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithKingsOnlyGame(t, 2*types.DefaultMaxMovesWithoutProgress-1)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+
+	msgServer.PlayMove(context, kingsShuffleMoves[0])
+
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	event := events[1]
+	require.Equal(t, event.Type, "move-played")
+	require.EqualValues(t, []sdk.Attribute{
+		{Key: "creator", Value: bob},
+		{Key: "game-index", Value: "1"},
+		{Key: "captured-x", Value: "-1"},
+		{Key: "captured-y", Value: "-1"},
+		{Key: "winner", Value: "d"},
+		{Key: "board", Value: "********|********|*B******|********|********|********|*******R|********"},
+	}, event.Attributes[len(event.Attributes)-6:])
+}
+
+func TestPlayMoveDrawCalledBank(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithKingsOnlyGame(t, 2*types.DefaultMaxMovesWithoutProgress-1)
+	defer ctrl.Finish()
+	refundBob := escrow.ExpectRefund(context, bob, 45).Times(1)
+	escrow.ExpectRefund(context, carol, 45).Times(1).After(refundBob)
+
+	_, err := msgServer.PlayMove(context, kingsShuffleMoves[0])
+	require.Nil(t, err)
+}
@@ -1,129 +0,0 @@
-package keeper_test
-
-import (
-	"testing"
-
-	"github.com/alice/checkers/x/checkers/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/stretchr/testify/require"
-)
-
-func TestPlayMove2Games1MoveHasSavedFifo(t *testing.T) {
-	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
-	ctx := sdk.UnwrapSDKContext(context)
-	defer ctrl.Finish()
-	escrow.ExpectAny(context)
-	msgServer.CreateGame(context, &types.MsgCreateGame{
-		Creator: bob,
-		Black:   carol,
-		Red:     alice,
-	})
-
-	msgServer.PlayMove(context, &types.MsgPlayMove{
-		Creator:   bob,
-		GameIndex: "1",
-		FromX:     1,
-		FromY:     2,
-		ToX:       2,
-		ToY:       3,
-	})
-	systemInfo1, found := keeper.GetSystemInfo(ctx)
-	require.True(t, found)
-	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "2",
-		FifoTailIndex: "1",
-	}, systemInfo1)
-	game1, found := keeper.GetStoredGame(ctx, "1")
-	require.True(t, found)
-	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "r",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   uint64(1),
-		BeforeIndex: "2",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-	}, game1)
-	game2, found := keeper.GetStoredGame(ctx, "2")
-	require.True(t, found)
-	require.EqualValues(t, types.StoredGame{
-		Index:       "2",
-		Board:       "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       carol,
-		Red:         alice,
-		MoveCount:   uint64(0),
-		BeforeIndex: "-1",
-		AfterIndex:  "1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-	}, game2)
-}
-
-func TestPlayMove2Games2MovesHasSavedFifo(t *testing.T) {
-	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
-	ctx := sdk.UnwrapSDKContext(context)
-	defer ctrl.Finish()
-	escrow.ExpectAny(context)
-	msgServer.CreateGame(context, &types.MsgCreateGame{
-		Creator: bob,
-		Black:   carol,
-		Red:     alice,
-	})
-	msgServer.PlayMove(context, &types.MsgPlayMove{
-		Creator:   bob,
-		GameIndex: "1",
-		FromX:     1,
-		FromY:     2,
-		ToX:       2,
-		ToY:       3,
-	})
-
-	msgServer.PlayMove(context, &types.MsgPlayMove{
-		Creator:   carol,
-		GameIndex: "2",
-		FromX:     1,
-		FromY:     2,
-		ToX:       2,
-		ToY:       3,
-	})
-	systemInfo1, found := keeper.GetSystemInfo(ctx)
-	require.True(t, found)
-	require.EqualValues(t, types.SystemInfo{
-		NextId:        3,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "2",
-	}, systemInfo1)
-	game1, found := keeper.GetStoredGame(ctx, "1")
-	require.True(t, found)
-	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "r",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   uint64(1),
-		BeforeIndex: "-1",
-		AfterIndex:  "2",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-	}, game1)
-	game2, found := keeper.GetStoredGame(ctx, "2")
-	require.True(t, found)
-	require.EqualValues(t, types.StoredGame{
-		Index:       "2",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "r",
-		Black:       carol,
-		Red:         alice,
-		MoveCount:   uint64(1),
-		BeforeIndex: "1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
-		Winner:      "*",
-	}, game2)
-}
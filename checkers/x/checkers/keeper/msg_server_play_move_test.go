@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	keepertest "github.com/alice/checkers/testutil/keeper"
 	"github.com/alice/checkers/x/checkers"
@@ -22,17 +23,25 @@ func setupMsgServerWithOneGameForPlayMove(t testing.TB) (types.MsgServer, keeper
 	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
 	server := keeper.NewMsgServerImpl(*k)
 	context := sdk.WrapSDKContext(ctx)
+	// Bob escrows his wager when creating the game, carol hers when accepting it.
+	payBob := bankMock.ExpectPay(context, bob, 45)
+	bankMock.ExpectPay(context, carol, 45).After(payBob)
 	server.CreateGame(context, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+	})
+	server.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
 	})
 	return server, *k, context, ctrl, bankMock
 }
 
 func TestPlayMove(t *testing.T) {
-	msgServer, _, context, _, _ := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
 	// plays a move
 	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
@@ -64,7 +73,9 @@ func TestPlayMove(t *testing.T) {
 **/
 
 func TestPlayMoveCannotParseGame(t *testing.T) {
-	msgServer, k, context, _, _ := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer, k, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
 	ctx := sdk.UnwrapSDKContext(context)
 	storedGame, _ := k.GetStoredGame(ctx, "1")
 	storedGame.Board = "not a board"
@@ -87,7 +98,9 @@ func TestPlayMoveCannotParseGame(t *testing.T) {
 
 // Tests if someone trys to play a move in a game that does not exist.
 func TestPlayMoveGameNotFound(t *testing.T) {
-	msgServer, _, context, _, _ := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
 	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "2",
@@ -102,17 +115,22 @@ func TestPlayMoveGameNotFound(t *testing.T) {
 
 // so the player can be a red and black player at the same time.
 func TestPlayMoveSameBlackRed(t *testing.T) {
-	msgServer, _, context := setupMsgServerCreateGame(t)
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	// Playing both sides, bob escrows both wagers and does not wait for anyone to accept.
+	escrow.ExpectPay(context, bob, 45).Times(2)
 
-	msgServer.CreateGame(context, &types.MsgCreateGame{
-		Creator: alice,
+	_, err := msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
 		Black:   bob,
 		Red:     bob,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
+	require.Nil(t, err)
 
 	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
-		GameIndex: "1",
+		GameIndex: "2",
 		FromX:     1,
 		FromY:     2,
 		ToX:       2,
@@ -130,7 +148,9 @@ func TestPlayMoveSameBlackRed(t *testing.T) {
 }
 
 func TestPlayMoveSavedGame(t *testing.T) {
-	msgServer, keeper, context, _, _ := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
 	ctx := sdk.UnwrapSDKContext(context)
 
 	msgServer.PlayMove(context, &types.MsgPlayMove{
@@ -146,29 +166,34 @@ func TestPlayMoveSavedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId: 2,
 	}, systemInfo)
 
 	// Get the stored game and check it is found then chekc that the stored game 1 equals the one in the test.
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "r",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   1,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
+		Index:           "1",
+		Board:           "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:            "r",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       1,
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl:     types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
+		Winner:          "*",
+		Wager:           45,
+		Denom:           "stake",
+		Status:          types.GameStatusActive,
+		Creator:         bob,
+		PositionHistory: []string{"*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*|r"},
 	}, game1)
 }
 
 func TestPlayMoveNotPlayer(t *testing.T) {
-	msgServer, _, context, _, _ := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
 	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   alice,
 		GameIndex: "1",
@@ -204,7 +229,9 @@ func TestPlayMoveNotPlayer(t *testing.T) {
 
 // Carol plays but it's not carols turn
 func TestPlayMoveWrongOutOfTurn(t *testing.T) {
-	msgServer, _, context, _, _ := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
 	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   carol,
 		GameIndex: "1",
@@ -218,7 +245,9 @@ func TestPlayMoveWrongOutOfTurn(t *testing.T) {
 }
 
 func TestPlayMoveWrongPieceAtDestination(t *testing.T) {
-	msgServer, _, context, _, _ := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
 	playMoveResponse, err := msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -232,7 +261,9 @@ func TestPlayMoveWrongPieceAtDestination(t *testing.T) {
 }
 
 func TestPlayMove2(t *testing.T) {
-	msgServer, _, context, _, _ := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -258,7 +289,9 @@ func TestPlayMove2(t *testing.T) {
 }
 
 func TestPlayMove2SavedGame(t *testing.T) {
-	msgServer, keeper, context, _, _ := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
 	ctx := sdk.UnwrapSDKContext(context)
 
 	msgServer.PlayMove(context, &types.MsgPlayMove{
@@ -282,9 +315,7 @@ func TestPlayMove2SavedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId: 2,
 	}, systemInfo)
 
 	game1, found := keeper.GetStoredGame(ctx, "1")
@@ -292,20 +323,27 @@ func TestPlayMove2SavedGame(t *testing.T) {
 	require.True(t, found)
 
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "b",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   2,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
+		Index:           "1",
+		Board:           "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:            "b",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       2,
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl:     types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
+		Winner:          "*",
+		Wager:           45,
+		Denom:           "stake",
+		Status:          types.GameStatusActive,
+		Creator:         bob,
+		PositionHistory: []string{"*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*|b"},
 	}, game1)
 }
 
 func TestPlayMove3(t *testing.T) {
-	msgServer, _, context, _, _ := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -339,7 +377,9 @@ func TestPlayMove3(t *testing.T) {
 }
 
 func TestPlayMove3SavedGame(t *testing.T) {
-	msgServer, keeper, context, _, _ := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
 	ctx := sdk.UnwrapSDKContext(context)
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
@@ -368,27 +408,32 @@ func TestPlayMove3SavedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "1",
-		FifoTailIndex: "1",
+		NextId: 2,
 	}, systemInfo)
 	game1, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
-		Index:       "1",
-		Board:       "*b*b*b*b|b*b*b*b*|***b*b*b|********|********|b*r*r*r*|*r*r*r*r|r*r*r*r*",
-		Turn:        "r",
-		Black:       bob,
-		Red:         carol,
-		MoveCount:   3,
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
+		Index:           "1",
+		Board:           "*b*b*b*b|b*b*b*b*|***b*b*b|********|********|b*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:            "r",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       3,
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl:     types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
+		Winner:          "*",
+		Wager:           45,
+		Denom:           "stake",
+		Status:          types.GameStatusActive,
+		Creator:         bob,
+		PositionHistory: []string{"*b*b*b*b|b*b*b*b*|***b*b*b|********|********|b*r*r*r*|*r*r*r*r|r*r*r*r*|r"},
 	}, game1)
 }
 
 func TestPlayMoveEmitted(t *testing.T) {
-	msgServer, _, context, _, _ := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -400,8 +445,8 @@ func TestPlayMoveEmitted(t *testing.T) {
 	ctx := sdk.UnwrapSDKContext(context)
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 3)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "move-played",
 		Attributes: []sdk.Attribute{
@@ -410,12 +455,15 @@ func TestPlayMoveEmitted(t *testing.T) {
 			{Key: "captured-x", Value: "-1"},
 			{Key: "captured-y", Value: "-1"},
 			{Key: "winner", Value: "*"},
+			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
 		},
 	}, event)
 }
 
 func TestPlayMove2Emitted(t *testing.T) {
-	msgServer, _, context, _, _ := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -435,8 +483,8 @@ func TestPlayMove2Emitted(t *testing.T) {
 	ctx := sdk.UnwrapSDKContext(context)
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 3)
+	event := events[1]
 	require.Equal(t, "move-played", event.Type)
 	require.EqualValues(t, []sdk.Attribute{
 		{Key: "creator", Value: carol},
@@ -444,11 +492,14 @@ func TestPlayMove2Emitted(t *testing.T) {
 		{Key: "captured-x", Value: "-1"},
 		{Key: "captured-y", Value: "-1"},
 		{Key: "winner", Value: "*"},
-	}, event.Attributes[5:])
+		{Key: "board", Value: "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|*r******|**r*r*r*|*r*r*r*r|r*r*r*r*"},
+	}, event.Attributes[6:])
 }
 
 func TestSavedPlayedDeadlineisParseable(t *testing.T) {
-	msgServer, keeper, context, _, _ := setupMsgServerWithOneGameForPlayMove(t)
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
 	ctx := sdk.UnwrapSDKContext(context)
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
@@ -470,14 +521,85 @@ func TestPlayMoveConsumeGas(t *testing.T) {
 	defer ctrl.Finish()
 	escrow.ExpectAny(context)
 	before := ctx.GasMeter().GasConsumed()
-	msgServer.PlayMove(context, &types.MsgPlayMove{
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
 		FromX:     1,
-		FromY:     1,
+		FromY:     2,
 		ToX:       2,
 		ToY:       3,
 	})
+	require.Nil(t, err)
 	after := ctx.GasMeter().GasConsumed()
 	require.GreaterOrEqual(t, after, before+5_000)
 }
+
+func setupMsgServerWithOneClockGameForPlayMove(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context,
+	*gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectAny(context)
+	server.CreateGame(context, &types.MsgCreateGame{
+		Creator:     bob,
+		Black:       bob,
+		Red:         carol,
+		Wager:       sdk.NewInt64Coin("stake", 45),
+		TimeControl: types.TimeControl{Clock: 10 * time.Minute, Increment: 5 * time.Second},
+	})
+	server.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	return server, *k, context, ctrl
+}
+
+func TestPlayMoveTakesTimeOffClock(t *testing.T) {
+	msgServer, keeper, context, ctrl := setupMsgServerWithOneClockGameForPlayMove(t)
+	defer ctrl.Finish()
+	ctx := sdk.UnwrapSDKContext(context).WithBlockTime(sdk.UnwrapSDKContext(context).BlockTime().Add(time.Minute))
+	msgServer.PlayMove(sdk.WrapSDKContext(ctx), &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, 9*time.Minute+5*time.Second, game.BlackClock)
+	require.Equal(t, 10*time.Minute, game.RedClock)
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime().Add(10*time.Minute)), game.Deadline)
+}
+
+func TestPlayMoveDeadlineFromClockOfOpponent(t *testing.T) {
+	msgServer, keeper, context, ctrl := setupMsgServerWithOneClockGameForPlayMove(t)
+	defer ctrl.Finish()
+	start := sdk.UnwrapSDKContext(context).BlockTime()
+	ctx1 := sdk.UnwrapSDKContext(context).WithBlockTime(start.Add(time.Minute))
+	msgServer.PlayMove(sdk.WrapSDKContext(ctx1), &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	ctx2 := ctx1.WithBlockTime(start.Add(4 * time.Minute))
+	msgServer.PlayMove(sdk.WrapSDKContext(ctx2), &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	game, found := keeper.GetStoredGame(ctx2, "1")
+	require.True(t, found)
+	require.Equal(t, 7*time.Minute+5*time.Second, game.RedClock)
+	require.Equal(t, types.FormatDeadline(ctx2.BlockTime().Add(9*time.Minute+5*time.Second)), game.Deadline)
+}
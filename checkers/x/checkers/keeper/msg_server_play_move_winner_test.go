@@ -1,11 +1,14 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
 
+	"github.com/alice/checkers/x/checkers/keeper"
 	"github.com/alice/checkers/x/checkers/testutil"
 	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -20,29 +23,29 @@ func TestPlayMoveUpToWinner(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId: 2,
 	}, systemInfo)
 
 	game, found := keeper.GetStoredGame(ctx, "1")
 	require.True(t, found)
 	require.EqualValues(t, types.StoredGame{
 		Index:       "1",
-		Board:       "",
-		Turn:        "b",
+		Board:       "*b*b****|**b*b***|*****b**|********|***B****|********|*****b**|********",
+		Turn:        "r",
 		Black:       bob,
 		Red:         carol,
 		MoveCount:   uint64(len(testutil.Game1Moves)),
-		BeforeIndex: "-1",
-		AfterIndex:  "-1",
-		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.MaxTurnDuration)),
+		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl: types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
 		Winner:      "b",
 		Wager:       45,
+		Denom:       "stake",
+		Status:      types.GameStatusFinished,
+		Creator:     bob,
 	}, game)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 2)
-	event := events[0]
+	require.Len(t, events, 3)
+	event := events[1]
 	require.Equal(t, event.Type, "move-played")
 	require.EqualValues(t, []sdk.Attribute{
 		{Key: "creator", Value: bob},
@@ -57,9 +60,115 @@ func TestPlayMoveUpToWinner(t *testing.T) {
 func TestPlayMoveUpToWinnerCalledBank(t *testing.T) {
 	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
 	defer ctrl.Finish()
-	payBob := escrow.ExpectPay(context, bob, 45).Times(1)
-	payCarol := escrow.ExpectPay(context, carol, 45).Times(1).After(payBob)
-	escrow.ExpectRefund(context, bob, 90).Times(1).After(payCarol)
+	// Both wagers are already in escrow once the game is accepted.
+	escrow.ExpectRefund(context, bob, 90).Times(1)
 
 	testutil.PlayAllMoves(t, msgServer, context, "1", testutil.Game1Moves)
 }
+
+func TestPlayMoveUpToWinnerRegistersResult(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+
+	testutil.PlayAllMoves(t, msgServer, context, "1", testutil.Game1Moves)
+
+	bobInfo, found := keeper.GetPlayerInfo(ctx, bob)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerInfo{
+		Index:       bob,
+		WonCount:    1,
+		PlayedCount: 1,
+		EloRating:   1216,
+	}, bobInfo)
+	carolInfo, found := keeper.GetPlayerInfo(ctx, carol)
+	require.True(t, found)
+	require.EqualValues(t, types.PlayerInfo{
+		Index:       carol,
+		LostCount:   1,
+		PlayedCount: 1,
+		EloRating:   1184,
+	}, carolInfo)
+}
+
+// Red still has a man, but it is boxed in by black men once black has played.
+const redBlockedBoard = "*****B**|********|********|**b*****|*b******|r*******|********|********"
+
+func setupMsgServerWithRedAboutToBeBlocked(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context, *gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	msgServer, k, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Board = redBlockedBoard
+	storedGame.MoveCount = 2
+	k.SetStoredGame(ctx, storedGame)
+	return msgServer, k, context, ctrl, escrow
+}
+
+func TestPlayMoveBlockedOpponentLoses(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithRedAboutToBeBlocked(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+
+	response, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     5,
+		FromY:     0,
+		ToX:       4,
+		ToY:       1,
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgPlayMoveResponse{
+		CapturedX: -1,
+		CapturedY: -1,
+		Winner:    "b",
+	}, *response)
+
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, types.StoredGame{
+		Index:       "1",
+		Board:       "********|****B***|********|**b*****|*b******|r*******|********|********",
+		Turn:        "r",
+		Black:       bob,
+		Red:         carol,
+		MoveCount:   3,
+		Deadline:    types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl: types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
+		Winner:      "b",
+		Wager:       45,
+		Denom:       "stake",
+		Status:      types.GameStatusFinished,
+		Creator:     bob,
+	}, game)
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 3)
+	event := events[1]
+	require.Equal(t, event.Type, "move-played")
+	require.EqualValues(t, []sdk.Attribute{
+		{Key: "creator", Value: bob},
+		{Key: "game-index", Value: "1"},
+		{Key: "captured-x", Value: "-1"},
+		{Key: "captured-y", Value: "-1"},
+		{Key: "winner", Value: "b"},
+		{Key: "board", Value: "********|****B***|********|**b*****|*b******|r*******|********|********"},
+	}, event.Attributes)
+}
+
+func TestPlayMoveBlockedOpponentLosesCalledBank(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithRedAboutToBeBlocked(t)
+	defer ctrl.Finish()
+	escrow.ExpectRefund(context, bob, 90).Times(1)
+
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     5,
+		FromY:     0,
+		ToX:       4,
+		ToY:       1,
+	})
+	require.Nil(t, err)
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) PlayMoves(goCtx context.Context, msg *types.MsgPlayMoves) (*types.MsgPlayMovesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	path := make([]rules.Pos, 0, len(msg.Path))
+	for _, position := range msg.Path {
+		path = append(path, rules.Pos{
+			X: int(position.X),
+			Y: int(position.Y),
+		})
+	}
+
+	captured, winner, err := k.playAlong(ctx, msg.Creator, msg.GameIndex, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var capturedPositions []types.Position
+	for _, capturedPos := range captured {
+		capturedPositions = append(capturedPositions, types.Position{
+			X: uint64(capturedPos.X),
+			Y: uint64(capturedPos.Y),
+		})
+	}
+	return &types.MsgPlayMovesResponse{
+		Captured: capturedPositions,
+		Winner:   winner,
+	}, nil
+}
@@ -0,0 +1,218 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/testutil"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+// Black can capture twice in a row with the man at 1,2, while red keeps a man to play with.
+const doubleJumpBoard = "********|********|*b******|**r*****|********|****r***|********|******r*"
+
+func setupMsgServerWithDoubleJumpGame(t testing.TB) (types.MsgServer, keeper.Keeper, context.Context, *gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	msgServer, k, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, _ := k.GetStoredGame(ctx, "1")
+	storedGame.Board = doubleJumpBoard
+	storedGame.MoveCount = 2
+	k.SetStoredGame(ctx, storedGame)
+	return msgServer, k, context, ctrl, escrow
+}
+
+func TestPlayMovesDoubleJump(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithDoubleJumpGame(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+
+	response, err := msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   bob,
+		GameIndex: "1",
+		Path:      []types.Position{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 6}},
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgPlayMovesResponse{
+		Captured: []types.Position{{X: 2, Y: 3}, {X: 4, Y: 5}},
+		Winner:   "*",
+	}, *response)
+}
+
+func TestPlayMovesDoubleJumpSavedGame(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithDoubleJumpGame(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+
+	msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   bob,
+		GameIndex: "1",
+		Path:      []types.Position{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 6}},
+	})
+
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, types.StoredGame{
+		Index:           "1",
+		Board:           "********|********|********|********|********|********|*****b**|******r*",
+		Turn:            "r",
+		Black:           bob,
+		Red:             carol,
+		MoveCount:       4,
+		Deadline:        types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl:     types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
+		Winner:          "*",
+		Wager:           45,
+		PositionHistory: []string{"********|********|********|********|********|********|*****b**|******r*|r"},
+		Denom:           "stake",
+		Status:          types.GameStatusActive,
+		Creator:         bob,
+	}, game)
+}
+
+// The black man at 5,2 could capture too, but not while the one at 1,2 is in the middle of its double jump.
+const twoCapturersBoard = "********|********|*b***b**|**r***r*|********|****r***|********|******r*"
+
+func TestPlayMoveCapturingPieceKeepsTheTurn(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithDoubleJumpGame(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+	storedGame, _ := keeper.GetStoredGame(ctx, "1")
+	storedGame.Board = twoCapturersBoard
+	keeper.SetStoredGame(ctx, storedGame)
+
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{Creator: bob, GameIndex: "1", FromX: 1, FromY: 2, ToX: 3, ToY: 4})
+	require.Nil(t, err)
+	storedGame, _ = keeper.GetStoredGame(ctx, "1")
+	require.Equal(t, "b", storedGame.Turn)
+	require.EqualValues(t, 18, storedGame.CapturingSquare)
+	require.Equal(t, []uint64{14}, storedGame.CapturedSquares)
+
+	_, err = msgServer.PlayMove(context, &types.MsgPlayMove{Creator: bob, GameIndex: "1", FromX: 5, FromY: 2, ToX: 7, ToY: 4})
+	require.EqualError(t, err, "Invalid move: {5 2} to {7 4}: wrong move")
+
+	_, err = msgServer.PlayMove(context, &types.MsgPlayMove{Creator: bob, GameIndex: "1", FromX: 3, FromY: 4, ToX: 5, ToY: 6})
+	require.Nil(t, err)
+	storedGame, _ = keeper.GetStoredGame(ctx, "1")
+	require.Equal(t, "r", storedGame.Turn)
+	require.EqualValues(t, 0, storedGame.CapturingSquare)
+	require.Empty(t, storedGame.CapturedSquares)
+}
+
+func TestPlayMovesDoubleJumpEmitted(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithDoubleJumpGame(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+
+	msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   bob,
+		GameIndex: "1",
+		Path:      []types.Position{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 6}},
+	})
+
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 3)
+	event := events[1]
+	require.Equal(t, event.Type, "move-played")
+	require.EqualValues(t, []sdk.Attribute{
+		{Key: "creator", Value: bob},
+		{Key: "game-index", Value: "1"},
+		{Key: "captured-x", Value: "2"},
+		{Key: "captured-y", Value: "3"},
+		{Key: "captured-x", Value: "4"},
+		{Key: "captured-y", Value: "5"},
+		{Key: "winner", Value: "*"},
+		{Key: "board", Value: "********|********|********|********|********|********|*****b**|******r*"},
+	}, event.Attributes)
+}
+
+func TestPlayMovesSimpleMove(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+
+	response, err := msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   bob,
+		GameIndex: "1",
+		Path:      []types.Position{{X: 1, Y: 2}, {X: 2, Y: 3}},
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgPlayMovesResponse{
+		Winner: "*",
+	}, *response)
+}
+
+func TestPlayMovesCannotKeepMovingAfterSimpleMove(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForPlayMove(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+
+	response, err := msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   bob,
+		GameIndex: "1",
+		Path:      []types.Position{{X: 1, Y: 2}, {X: 2, Y: 3}, {X: 3, Y: 4}},
+	})
+	require.Nil(t, response)
+	require.Equal(t, "Cannot keep moving after {2 3}: wrong move", err.Error())
+}
+
+func TestPlayMovesWrongSecondJumpChangesNothing(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithDoubleJumpGame(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+
+	response, err := msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   bob,
+		GameIndex: "1",
+		Path:      []types.Position{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 4, Y: 5}},
+	})
+	require.Nil(t, response)
+	require.Equal(t, "Already piece at destination position: {4 5}: wrong move", err.Error())
+
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, doubleJumpBoard, game.Board)
+	require.EqualValues(t, 2, game.MoveCount)
+}
+
+func TestPlayMovesConsumeGasPerHop(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithDoubleJumpGame(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+
+	before := ctx.GasMeter().GasConsumed()
+	_, err := msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   bob,
+		GameIndex: "1",
+		Path:      []types.Position{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 6}},
+	})
+	require.Nil(t, err)
+	after := ctx.GasMeter().GasConsumed()
+	require.GreaterOrEqual(t, after, before+2*types.DefaultPlayMoveGas)
+}
+
+func TestPlayMovesConsumeGasPerHopFromParams(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithDoubleJumpGame(t)
+	defer ctrl.Finish()
+	escrow.ExpectAny(context)
+	ctx := sdk.UnwrapSDKContext(context)
+	params := types.DefaultParams()
+	params.PlayMoveGas = 1_000_000
+	keeper.SetParams(ctx, params)
+
+	before := ctx.GasMeter().GasConsumed()
+	_, err := msgServer.PlayMoves(context, &types.MsgPlayMoves{
+		Creator:   bob,
+		GameIndex: "1",
+		Path:      []types.Position{{X: 1, Y: 2}, {X: 3, Y: 4}, {X: 5, Y: 6}},
+	})
+	require.Nil(t, err)
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), before+2_000_000)
+}
@@ -23,35 +23,47 @@ func (k msgServer) RejectGame(goCtx context.Context, msg *types.MsgRejectGame) (
 		return nil, types.ErrGameFinished
 	}
 
+	// The tournament could not go on without the game's result.
+	if storedGame.TournamentIndex != "" {
+		return nil, types.ErrTournamentGameRejected
+	}
+
+	// Whoever moves first, by the variant or by the position the game was set up from, can reject
+	// until they play, the other player until they play in turn.
+	startGame, err := storedGame.ParseStartGame()
+	if err != nil {
+		return nil, err
+	}
+	var color string
 	if storedGame.Black == msg.Creator {
-		if 0 < storedGame.MoveCount { // Notice the use of the new field
-			return nil, types.ErrBlackAlreadyPlayed
-		}
+		color = rules.PieceStrings[rules.BLACK_PLAYER]
 	} else if storedGame.Red == msg.Creator {
-		if 1 < storedGame.MoveCount { // Notice the use of the new field
-			return nil, types.ErrRedAlreadyPlayed
-		}
+		color = rules.PieceStrings[rules.RED_PLAYER]
 	} else {
 		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
 	}
+	played := 0 < storedGame.MoveCount
+	if color != rules.PieceStrings[startGame.Turn] {
+		// The opening move may take several hops, so look for the player's own move instead.
+		played = k.Keeper.HasPlayed(ctx, storedGame.Index, color)
+	}
+	if played {
+		if color == rules.PieceStrings[rules.BLACK_PLAYER] {
+			return nil, types.ErrBlackAlreadyPlayed
+		}
+		return nil, types.ErrRedAlreadyPlayed
+	}
 
 	// Refund the wager
 	k.Keeper.MustRefundWager(ctx, &storedGame)
 
-	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
-	if !found {
-		panic("SystemInfo not found")
-	}
-
 	// remove the stored game created when using ignite scaffold for the stored game.
-	k.Keeper.RemoveFromFifo(ctx, &storedGame, &systemInfo)
 	k.Keeper.RemoveStoredGame(ctx, msg.GameIndex)
-	// this is set since it is updated in the remove fifo function.
-	k.Keeper.SetSystemInfo(ctx, systemInfo)
+	k.Keeper.RemoveGameMoves(ctx, msg.GameIndex)
 
 	// When handling game rejection, you make sure that you are not
 	// refunding more than what has already been consumed.
-	refund := uint64(types.RejectGameRefundGas)
+	refund := k.Keeper.RejectGameRefundGas(ctx)
 	if consumed := ctx.GasMeter().GasConsumed(); consumed < refund {
 		refund = consumed
 	}
@@ -22,11 +22,12 @@ func setupMsgServerWithOneGameForRejectGame(t testing.TB) (types.MsgServer, keep
 	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
 	server := keeper.NewMsgServerImpl(*k)
 	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectPay(context, bob, 45)
 	server.CreateGame(context, &types.MsgCreateGame{
-		Creator: alice,
+		Creator: bob,
 		Black:   bob,
 		Red:     carol,
-		Wager:   45,
+		Wager:   sdk.NewInt64Coin("stake", 45),
 	})
 	return server, *k, context, ctrl, bankMock
 }
@@ -43,8 +44,10 @@ func TestRejectGameWrongByCreator(t *testing.T) {
 }
 
 func TestRejectGameByBlackNoMove(t *testing.T) {
-	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForRejectGame(t)
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForRejectGame(t)
 	defer ctrl.Finish()
+	// The game was never accepted, so only bob has paid.
+	escrow.ExpectRefund(context, bob, 45)
 	rejectGameResponse, err := msgServer.RejectGame(context, &types.MsgRejectGame{
 		Creator:   bob,
 		GameIndex: "1",
@@ -54,9 +57,10 @@ func TestRejectGameByBlackNoMove(t *testing.T) {
 }
 
 func TestRejectGameByBlackNoMoveRemovedGame(t *testing.T) {
-	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForRejectGame(t)
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForRejectGame(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
+	escrow.ExpectRefund(context, bob, 45)
 	msgServer.RejectGame(context, &types.MsgRejectGame{
 		Creator:   bob,
 		GameIndex: "1",
@@ -64,18 +68,17 @@ func TestRejectGameByBlackNoMoveRemovedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId: 2,
 	}, systemInfo)
 	_, found = keeper.GetStoredGame(ctx, "1")
 	require.False(t, found)
 }
 
 func TestRejectGameByBlackNoMoveEmitted(t *testing.T) {
-	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForRejectGame(t)
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForRejectGame(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
+	escrow.ExpectRefund(context, bob, 45)
 	msgServer.RejectGame(context, &types.MsgRejectGame{
 		Creator:   bob,
 		GameIndex: "1",
@@ -94,8 +97,9 @@ func TestRejectGameByBlackNoMoveEmitted(t *testing.T) {
 }
 
 func TestRejectGameByRedNoMove(t *testing.T) {
-	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForRejectGame(t)
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForRejectGame(t)
 	defer ctrl.Finish()
+	escrow.ExpectRefund(context, bob, 45)
 	rejectGameResponse, err := msgServer.RejectGame(context, &types.MsgRejectGame{
 		Creator:   carol,
 		GameIndex: "1",
@@ -105,9 +109,10 @@ func TestRejectGameByRedNoMove(t *testing.T) {
 }
 
 func TestRejectGameByRedNoMoveRemovedGame(t *testing.T) {
-	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForRejectGame(t)
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForRejectGame(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
+	escrow.ExpectRefund(context, bob, 45)
 	msgServer.RejectGame(context, &types.MsgRejectGame{
 		Creator:   carol,
 		GameIndex: "1",
@@ -115,18 +120,17 @@ func TestRejectGameByRedNoMoveRemovedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId: 2,
 	}, systemInfo)
 	_, found = keeper.GetStoredGame(ctx, "1")
 	require.False(t, found)
 }
 
 func TestRejectGameByRedNoMoveEmitted(t *testing.T) {
-	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForRejectGame(t)
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForRejectGame(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
+	escrow.ExpectRefund(context, bob, 45)
 	msgServer.RejectGame(context, &types.MsgRejectGame{
 		Creator:   carol,
 		GameIndex: "1",
@@ -148,6 +152,10 @@ func TestRejectGameByRedOneMove(t *testing.T) {
 	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForRejectGame(t)
 	defer ctrl.Finish()
 	escrow.ExpectAny(context)
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -169,6 +177,10 @@ func TestRejectGameByRedOneMoveRemovedGame(t *testing.T) {
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
 	escrow.ExpectAny(context)
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -184,9 +196,7 @@ func TestRejectGameByRedOneMoveRemovedGame(t *testing.T) {
 	systemInfo, found := keeper.GetSystemInfo(ctx)
 	require.True(t, found)
 	require.EqualValues(t, types.SystemInfo{
-		NextId:        2,
-		FifoHeadIndex: "-1",
-		FifoTailIndex: "-1",
+		NextId: 2,
 	}, systemInfo)
 	_, found = keeper.GetStoredGame(ctx, "1")
 	require.False(t, found)
@@ -197,6 +207,10 @@ func TestRejectGameByRedOneMoveEmitted(t *testing.T) {
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
 	escrow.ExpectAny(context)
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -211,8 +225,8 @@ func TestRejectGameByRedOneMoveEmitted(t *testing.T) {
 	})
 	require.NotNil(t, ctx)
 	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
-	require.Len(t, events, 3)
-	event := events[0]
+	require.Len(t, events, 4)
+	event := events[1]
 	require.EqualValues(t, sdk.StringEvent{
 		Type: "game-rejected",
 		Attributes: []sdk.Attribute{
@@ -225,8 +239,13 @@ func TestRejectGameByRedOneMoveEmitted(t *testing.T) {
 func TestRejectGameByRedOneCalledBank(t *testing.T) {
 	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForRejectGame(t)
 	defer ctrl.Finish()
-	payBob := escrow.ExpectPay(context, bob, 45).Times(1)
-	escrow.ExpectRefund(context, bob, 45).Times(1).After(payBob)
+	payCarol := escrow.ExpectPay(context, carol, 45).Times(1)
+	escrow.ExpectRefund(context, bob, 45).Times(1).After(payCarol)
+	escrow.ExpectRefund(context, carol, 45).Times(1).After(payCarol)
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -244,7 +263,11 @@ func TestRejectGameByRedOneCalledBank(t *testing.T) {
 func TestRejectGameByBlackWrongOneMove(t *testing.T) {
 	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForRejectGame(t)
 	defer ctrl.Finish()
-	escrow.ExpectPay(context, bob, 45).Times(1)
+	escrow.ExpectPay(context, carol, 45).Times(1)
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -264,8 +287,11 @@ func TestRejectGameByBlackWrongOneMove(t *testing.T) {
 func TestRejectGameByRedWrong2Moves(t *testing.T) {
 	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForRejectGame(t)
 	defer ctrl.Finish()
-	payBob := escrow.ExpectPay(context, bob, 45).Times(1)
-	escrow.ExpectPay(context, carol, 45).Times(1).After(payBob)
+	escrow.ExpectPay(context, carol, 45).Times(1)
+	msgServer.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
 	msgServer.PlayMove(context, &types.MsgPlayMove{
 		Creator:   bob,
 		GameIndex: "1",
@@ -290,16 +316,242 @@ func TestRejectGameByRedWrong2Moves(t *testing.T) {
 	require.Equal(t, "red player has already played", err.Error())
 }
 
-//These are lame tests because 5_000 cannot be predicted but have to be found via trial and error.
+// The reject itself costs gas, so the refund is measured against a reject that gets none. Reading a larger
+// param costs a little, which is why 13_000 was found via trial and error.
 func TestRejectGameByBlackRefundedGas(t *testing.T) {
-	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForRejectGame(t)
-	ctx := sdk.UnwrapSDKContext(context)
+	rejectGas := func(refund uint64) uint64 {
+		msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForRejectGame(t)
+		ctx := sdk.UnwrapSDKContext(context)
+		defer ctrl.Finish()
+		params := types.DefaultParams()
+		params.RejectGameRefundGas = refund
+		keeper.SetParams(ctx, params)
+		escrow.ExpectRefund(context, bob, 45)
+		before := ctx.GasMeter().GasConsumed()
+		msgServer.RejectGame(context, &types.MsgRejectGame{
+			Creator:   bob,
+			GameIndex: "1",
+		})
+		return ctx.GasMeter().GasConsumed() - before
+	}
+	withoutRefund := rejectGas(0)
+	require.LessOrEqual(t, rejectGas(types.DefaultRejectGameRefundGas)+13_000, withoutRefund)
+}
+
+// In Russian checkers red moves first, so black can still reject after red's first move.
+func setupMsgServerWithOneRussianGameForRejectGame(t testing.TB) (types.MsgServer, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectAny(context)
+	server.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+		Variant: "russian",
+	})
+	server.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	return server, context, ctrl, bankMock
+}
+
+func TestRejectRedFirstGameByRedNoMove(t *testing.T) {
+	msgServer, context, ctrl, _ := setupMsgServerWithOneRussianGameForRejectGame(t)
 	defer ctrl.Finish()
-	before := ctx.GasMeter().GasConsumed()
-	msgServer.RejectGame(context, &types.MsgRejectGame{
+	_, err := msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+}
+
+func TestRejectRedFirstGameByBlackAfterRedMove(t *testing.T) {
+	msgServer, context, ctrl, _ := setupMsgServerWithOneRussianGameForRejectGame(t)
+	defer ctrl.Finish()
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	require.Nil(t, err)
+	_, err = msgServer.RejectGame(context, &types.MsgRejectGame{
 		Creator:   bob,
 		GameIndex: "1",
 	})
-	after := ctx.GasMeter().GasConsumed()
-	require.LessOrEqual(t, after, before-5_000)
+	require.Nil(t, err)
+}
+
+func TestRejectRedFirstGameByRedWrongOneMove(t *testing.T) {
+	msgServer, context, ctrl, _ := setupMsgServerWithOneRussianGameForRejectGame(t)
+	defer ctrl.Finish()
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	rejectGameResponse, err := msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, rejectGameResponse)
+	require.Equal(t, "red player has already played", err.Error())
+}
+
+func TestRejectRedFirstGameByBlackWrong2Moves(t *testing.T) {
+	msgServer, context, ctrl, _ := setupMsgServerWithOneRussianGameForRejectGame(t)
+	defer ctrl.Finish()
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+	rejectGameResponse, err := msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, rejectGameResponse)
+	require.Equal(t, "black player has already played", err.Error())
+}
+
+// Set up from a position with white, that is red, to move, where red's first move captures twice.
+func setupMsgServerWithOneWhiteToMoveGameForRejectGame(t testing.TB) (types.MsgServer, context.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	server := keeper.NewMsgServerImpl(*k)
+	context := sdk.WrapSDKContext(ctx)
+	bankMock.ExpectAny(context)
+	server.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+		Fen:     "W:W31:B1,17,26",
+	})
+	server.AcceptGame(context, &types.MsgAcceptGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	return server, context, ctrl, bankMock
+}
+
+func TestRejectWhiteToMoveGameByRedNoMove(t *testing.T) {
+	msgServer, context, ctrl, _ := setupMsgServerWithOneWhiteToMoveGameForRejectGame(t)
+	defer ctrl.Finish()
+	_, err := msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+}
+
+func TestRejectWhiteToMoveGameByRedWrongMidCapture(t *testing.T) {
+	msgServer, context, ctrl, _ := setupMsgServerWithOneWhiteToMoveGameForRejectGame(t)
+	defer ctrl.Finish()
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     4,
+		FromY:     7,
+		ToX:       2,
+		ToY:       5,
+	})
+	require.Nil(t, err)
+	rejectGameResponse, err := msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, rejectGameResponse)
+	require.Equal(t, "red player has already played", err.Error())
+}
+
+func TestRejectWhiteToMoveGameByBlackAfterRedDoubleJump(t *testing.T) {
+	msgServer, context, ctrl, _ := setupMsgServerWithOneWhiteToMoveGameForRejectGame(t)
+	defer ctrl.Finish()
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     4,
+		FromY:     7,
+		ToX:       2,
+		ToY:       5,
+	})
+	require.Nil(t, err)
+	_, err = msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     2,
+		FromY:     5,
+		ToX:       0,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+	_, err = msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+}
+
+func TestRejectWhiteToMoveGameByBlackWrongAfterMove(t *testing.T) {
+	msgServer, context, ctrl, _ := setupMsgServerWithOneWhiteToMoveGameForRejectGame(t)
+	defer ctrl.Finish()
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     4,
+		FromY:     7,
+		ToX:       2,
+		ToY:       5,
+	})
+	msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     2,
+		FromY:     5,
+		ToX:       0,
+		ToY:       3,
+	})
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     0,
+		ToX:       2,
+		ToY:       1,
+	})
+	require.Nil(t, err)
+	rejectGameResponse, err := msgServer.RejectGame(context, &types.MsgRejectGame{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, rejectGameResponse)
+	require.Equal(t, "black player has already played", err.Error())
 }
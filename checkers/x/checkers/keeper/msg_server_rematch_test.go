@@ -0,0 +1,226 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alice/checkers/x/checkers/testutil"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func finishGameForRematch(t *testing.T, msgServer types.MsgServer, context context.Context, escrow *testutil.MockBankEscrowKeeper) {
+	escrow.ExpectRefund(context, carol, 90).Times(1)
+	_, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+}
+
+func TestOfferRematchSaved(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	finishGameForRematch(t, msgServer, context, escrow)
+	offerResponse, err := msgServer.OfferRematch(context, &types.MsgOfferRematch{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgOfferRematchResponse{}, *offerResponse)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "b", game.RematchOffer)
+	require.Equal(t, "", game.RematchGame)
+}
+
+func TestOfferRematchEmitted(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	finishGameForRematch(t, msgServer, context, escrow)
+	msgServer.OfferRematch(context, &types.MsgOfferRematch{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	var offered []sdk.StringEvent
+	for _, event := range sdk.StringifyEvents(ctx.EventManager().ABCIEvents()) {
+		if event.Type == "rematch-offered" {
+			offered = append(offered, event)
+		}
+	}
+	require.EqualValues(t, []sdk.StringEvent{{
+		Type: "rematch-offered",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: carol},
+			{Key: "game-index", Value: "1"},
+		},
+	}}, offered)
+}
+
+func TestOfferRematchNotFinished(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	offerResponse, err := msgServer.OfferRematch(context, &types.MsgOfferRematch{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, offerResponse)
+	require.Equal(t, "only a finished game can be played again", err.Error())
+}
+
+func TestOfferRematchNotPlayer(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	finishGameForRematch(t, msgServer, context, escrow)
+	offerResponse, err := msgServer.OfferRematch(context, &types.MsgOfferRematch{
+		Creator:   alice,
+		GameIndex: "1",
+	})
+	require.Nil(t, offerResponse)
+	require.Equal(t, alice+": message creator is not a player", err.Error())
+}
+
+func TestOfferRematchTwice(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	finishGameForRematch(t, msgServer, context, escrow)
+	msgServer.OfferRematch(context, &types.MsgOfferRematch{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	offerResponse, err := msgServer.OfferRematch(context, &types.MsgOfferRematch{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, offerResponse)
+	require.Equal(t, "b: a rematch is already offered by: %s", err.Error())
+}
+
+func TestAcceptRematchCreatesSwappedGame(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	finishGameForRematch(t, msgServer, context, escrow)
+	msgServer.OfferRematch(context, &types.MsgOfferRematch{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	payCarol := escrow.ExpectPay(context, carol, 45).Times(1)
+	escrow.ExpectPay(context, bob, 45).Times(1).After(payCarol)
+	acceptResponse, err := msgServer.AcceptRematch(context, &types.MsgAcceptRematch{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgAcceptRematchResponse{GameIndex: "2"}, *acceptResponse)
+
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId: 3,
+	}, systemInfo)
+	previous, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "", previous.RematchOffer)
+	require.Equal(t, "2", previous.RematchGame)
+	rematch, found := keeper.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	require.EqualValues(t, types.StoredGame{
+		Index:        "2",
+		Board:        "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*",
+		Turn:         "b",
+		Black:        carol,
+		Red:          bob,
+		MoveCount:    0,
+		Deadline:     types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)),
+		TimeControl:  types.TimeControl{TurnDuration: types.DefaultMaxTurnDuration},
+		Winner:       "*",
+		Wager:        45,
+		Denom:        "stake",
+		Status:       types.GameStatusActive,
+		Creator:      carol,
+		PreviousGame: "1",
+	}, rematch)
+}
+
+func TestAcceptRematchEmitted(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	finishGameForRematch(t, msgServer, context, escrow)
+	msgServer.OfferRematch(context, &types.MsgOfferRematch{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	escrow.ExpectAny(context)
+	msgServer.AcceptRematch(context, &types.MsgAcceptRematch{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	var accepted []sdk.StringEvent
+	for _, event := range sdk.StringifyEvents(ctx.EventManager().ABCIEvents()) {
+		if event.Type == "rematch-accepted" {
+			accepted = append(accepted, event)
+		}
+	}
+	require.EqualValues(t, []sdk.StringEvent{{
+		Type: "rematch-accepted",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: bob},
+			{Key: "game-index", Value: "1"},
+			{Key: "rematch-index", Value: "2"},
+		},
+	}}, accepted)
+}
+
+func TestAcceptRematchOwnOffer(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	finishGameForRematch(t, msgServer, context, escrow)
+	msgServer.OfferRematch(context, &types.MsgOfferRematch{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	acceptResponse, err := msgServer.AcceptRematch(context, &types.MsgAcceptRematch{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, acceptResponse)
+	require.Equal(t, "player cannot answer their own rematch offer", err.Error())
+}
+
+func TestAcceptRematchNoOffer(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	finishGameForRematch(t, msgServer, context, escrow)
+	acceptResponse, err := msgServer.AcceptRematch(context, &types.MsgAcceptRematch{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, acceptResponse)
+	require.Equal(t, "there is no rematch offer to answer", err.Error())
+}
+
+func TestOfferRematchAlreadyPlayed(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	finishGameForRematch(t, msgServer, context, escrow)
+	msgServer.OfferRematch(context, &types.MsgOfferRematch{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	escrow.ExpectAny(context)
+	msgServer.AcceptRematch(context, &types.MsgAcceptRematch{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	offerResponse, err := msgServer.OfferRematch(context, &types.MsgOfferRematch{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, offerResponse)
+	require.Equal(t, "2: the game already has a rematch: %s", err.Error())
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) RequestTakeback(goCtx context.Context, msg *types.MsgRequestTakeback) (*types.MsgRequestTakebackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil, types.ErrGameFinished
+	}
+	if storedGame.Status == types.GameStatusPending {
+		return nil, types.ErrGameNotAccepted
+	}
+
+	isBlack := storedGame.Black == msg.Creator
+	isRed := storedGame.Red == msg.Creator
+	var requester string
+	if !isBlack && !isRed {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	} else if isBlack && isRed {
+		// Playing both sides, it is the side that just moved that wants its move back.
+		requester = rules.PieceStrings[rules.BLACK_PLAYER]
+		if storedGame.Turn == requester {
+			requester = rules.PieceStrings[rules.RED_PLAYER]
+		}
+	} else if isBlack {
+		requester = rules.PieceStrings[rules.BLACK_PLAYER]
+	} else {
+		requester = rules.PieceStrings[rules.RED_PLAYER]
+	}
+
+	// Only one request can be pending at a time, the other player has to answer it first.
+	if storedGame.TakebackRequest != "" {
+		return nil, sdkerrors.Wrapf(types.ErrTakebackAlreadyRequested, "%s", storedGame.TakebackRequest)
+	}
+	if k.Keeper.MaxTakebacks(ctx) <= storedGame.TakebackCount {
+		return nil, types.ErrNoTakebacksLeft
+	}
+	gameMoves, err := k.Keeper.getGameMovesToTakeBack(ctx, storedGame)
+	if err != nil {
+		return nil, err
+	}
+	if played := countPlies(gameMoves); played < msg.Plies {
+		return nil, sdkerrors.Wrapf(types.ErrNotEnoughPlies, "%d", played)
+	}
+
+	storedGame.TakebackRequest = requester
+	storedGame.TakebackPlies = msg.Plies
+	k.Keeper.SetStoredGame(ctx, storedGame)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.TakebackRequestedEventType,
+			sdk.NewAttribute(types.TakebackRequestedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.TakebackRequestedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.TakebackRequestedEventPlies, strconv.FormatUint(msg.Plies, 10)),
+		),
+	)
+
+	return &types.MsgRequestTakebackResponse{}, nil
+}
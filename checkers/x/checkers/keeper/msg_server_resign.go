@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) Resign(goCtx context.Context, msg *types.MsgResign) (*types.MsgResignResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	storedGame, found := k.Keeper.GetStoredGame(ctx, msg.GameIndex)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", msg.GameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return nil, types.ErrGameFinished
+	}
+	// Until both wagers are in escrow, the game can still be rejected instead.
+	if storedGame.Status == types.GameStatusPending {
+		return nil, types.ErrGameNotAccepted
+	}
+
+	isBlack := storedGame.Black == msg.Creator
+	isRed := storedGame.Red == msg.Creator
+	if !isBlack && !isRed {
+		return nil, sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", msg.Creator)
+	} else if isBlack && isRed {
+		// Playing both sides, it is the side to move that gives up.
+		isBlack = storedGame.Turn == rules.PieceStrings[rules.BLACK_PLAYER]
+	}
+
+	systemInfo, found := k.Keeper.GetSystemInfo(ctx)
+	if !found {
+		panic("SystemInfo not found")
+	}
+
+	if isBlack {
+		storedGame.Winner = rules.PieceStrings[rules.RED_PLAYER]
+	} else {
+		storedGame.Winner = rules.PieceStrings[rules.BLACK_PLAYER]
+	}
+	storedGame.DrawOffer = ""
+	storedGame.PositionHistory = nil
+	storedGame.MovesWithoutProgress = 0
+	storedGame.CapturingSquare = 0
+	storedGame.CapturedSquares = nil
+	k.Keeper.MustPayWinnings(ctx, &storedGame)
+	k.Keeper.MustRegisterGameResult(ctx, &storedGame, false)
+	if err := k.Keeper.RegisterTournamentResult(ctx, &storedGame, &systemInfo); err != nil {
+		return nil, err
+	}
+	storedGame.Status = types.GameStatusFinished
+
+	k.Keeper.SetStoredGame(ctx, storedGame)
+	k.Keeper.SetSystemInfo(ctx, systemInfo)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.GameResignedEventType,
+			sdk.NewAttribute(types.GameResignedEventCreator, msg.Creator),
+			sdk.NewAttribute(types.GameResignedEventGameIndex, msg.GameIndex),
+			sdk.NewAttribute(types.GameResignedEventWinner, storedGame.Winner),
+			sdk.NewAttribute(types.GameResignedEventBoard, storedGame.Board),
+		),
+	)
+
+	return &types.MsgResignResponse{}, nil
+}
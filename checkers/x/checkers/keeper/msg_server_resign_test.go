@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResignByBlackPaysRed(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectRefund(context, carol, 90).Times(1)
+	resignResponse, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgResignResponse{}, *resignResponse)
+
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, types.SystemInfo{
+		NextId: 2,
+	}, systemInfo)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "r", game.Winner)
+	require.Equal(t, types.GameStatusFinished, game.Status)
+	require.False(t, game.Forfeited)
+	carolInfo, found := keeper.GetPlayerInfo(ctx, carol)
+	require.True(t, found)
+	require.EqualValues(t, 1, carolInfo.WonCount)
+}
+
+func TestResignByRedOnBlackTurn(t *testing.T) {
+	msgServer, keeper, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectRefund(context, bob, 90).Times(1)
+	msgServer.OfferDraw(context, &types.MsgOfferDraw{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	_, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.Equal(t, "b", game.Winner)
+	require.Equal(t, "", game.DrawOffer)
+}
+
+func TestResignEmitted(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectRefund(context, carol, 90).Times(1)
+	msgServer.Resign(context, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	var resigned []sdk.StringEvent
+	for _, event := range sdk.StringifyEvents(ctx.EventManager().ABCIEvents()) {
+		if event.Type == "game-resigned" {
+			resigned = append(resigned, event)
+		}
+	}
+	require.EqualValues(t, []sdk.StringEvent{{
+		Type: "game-resigned",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: bob},
+			{Key: "game-index", Value: "1"},
+			{Key: "winner", Value: "r"},
+			{Key: "board", Value: "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"},
+		},
+	}}, resigned)
+}
+
+func TestResignNotPlayer(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	resignResponse, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   alice,
+		GameIndex: "1",
+	})
+	require.Nil(t, resignResponse)
+	require.Equal(t, alice+": message creator is not a player", err.Error())
+}
+
+func TestResignNotAccepted(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, bob, 45).Times(1)
+	msgServer.CreateGame(context, &types.MsgCreateGame{
+		Creator: bob,
+		Black:   bob,
+		Red:     carol,
+		Wager:   sdk.NewInt64Coin("stake", 45),
+	})
+	resignResponse, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "2",
+	})
+	require.Nil(t, resignResponse)
+	require.Equal(t, "game has not been accepted yet", err.Error())
+}
+
+func TestResignTwice(t *testing.T) {
+	msgServer, _, context, ctrl, escrow := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	escrow.ExpectRefund(context, carol, 90).Times(1)
+	msgServer.Resign(context, &types.MsgResign{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	resignResponse, err := msgServer.Resign(context, &types.MsgResign{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, resignResponse)
+	require.Equal(t, "game is already finished", err.Error())
+}
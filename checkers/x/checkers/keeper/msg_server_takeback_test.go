@@ -0,0 +1,317 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const startBoard = "*b*b*b*b|b*b*b*b*|*b*b*b*b|********|********|r*r*r*r*|*r*r*r*r|r*r*r*r*"
+
+func playTwoPliesForTakeback(t *testing.T, msgServer types.MsgServer, context sdk.Context) {
+	_, err := msgServer.PlayMove(sdk.WrapSDKContext(context), &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+	_, err = msgServer.PlayMove(sdk.WrapSDKContext(context), &types.MsgPlayMove{
+		Creator:   carol,
+		GameIndex: "1",
+		FromX:     0,
+		FromY:     5,
+		ToX:       1,
+		ToY:       4,
+	})
+	require.Nil(t, err)
+}
+
+func TestRequestTakebackSaved(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	playTwoPliesForTakeback(t, msgServer, ctx)
+	requestResponse, err := msgServer.RequestTakeback(context, &types.MsgRequestTakeback{
+		Creator:   carol,
+		GameIndex: "1",
+		Plies:     2,
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgRequestTakebackResponse{}, *requestResponse)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, "r", game.TakebackRequest)
+	require.EqualValues(t, 2, game.TakebackPlies)
+	require.EqualValues(t, 0, game.TakebackCount)
+	require.EqualValues(t, 2, game.MoveCount)
+}
+
+func TestRequestTakebackEmitted(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	playTwoPliesForTakeback(t, msgServer, ctx)
+	msgServer.RequestTakeback(context, &types.MsgRequestTakeback{
+		Creator:   bob,
+		GameIndex: "1",
+		Plies:     1,
+	})
+	var requested []sdk.StringEvent
+	for _, event := range sdk.StringifyEvents(ctx.EventManager().ABCIEvents()) {
+		if event.Type == "takeback-requested" {
+			requested = append(requested, event)
+		}
+	}
+	require.EqualValues(t, []sdk.StringEvent{{
+		Type: "takeback-requested",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: bob},
+			{Key: "game-index", Value: "1"},
+			{Key: "plies", Value: "1"},
+		},
+	}}, requested)
+}
+
+func TestRequestTakebackNotEnoughPlies(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	requestResponse, err := msgServer.RequestTakeback(context, &types.MsgRequestTakeback{
+		Creator:   bob,
+		GameIndex: "1",
+		Plies:     1,
+	})
+	require.Nil(t, requestResponse)
+	require.Equal(t, "0: cannot take back more plies than were played: %d", err.Error())
+}
+
+func TestRequestTakebackNotPlayer(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	requestResponse, err := msgServer.RequestTakeback(context, &types.MsgRequestTakeback{
+		Creator:   alice,
+		GameIndex: "1",
+		Plies:     1,
+	})
+	require.Nil(t, requestResponse)
+	require.Equal(t, alice+": message creator is not a player", err.Error())
+}
+
+func TestRequestTakebackTwice(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	playTwoPliesForTakeback(t, msgServer, ctx)
+	msgServer.RequestTakeback(context, &types.MsgRequestTakeback{
+		Creator:   carol,
+		GameIndex: "1",
+		Plies:     1,
+	})
+	requestResponse, err := msgServer.RequestTakeback(context, &types.MsgRequestTakeback{
+		Creator:   bob,
+		GameIndex: "1",
+		Plies:     1,
+	})
+	require.Nil(t, requestResponse)
+	require.Equal(t, "r: a takeback is already requested by: %s", err.Error())
+}
+
+func TestAcceptTakebackRestoresGame(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	playTwoPliesForTakeback(t, msgServer, ctx)
+	msgServer.RequestTakeback(context, &types.MsgRequestTakeback{
+		Creator:   carol,
+		GameIndex: "1",
+		Plies:     2,
+	})
+	acceptResponse, err := msgServer.AcceptTakeback(context, &types.MsgAcceptTakeback{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgAcceptTakebackResponse{}, *acceptResponse)
+	game, found := keeper.GetStoredGame(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, startBoard, game.Board)
+	require.Equal(t, "b", game.Turn)
+	require.EqualValues(t, 0, game.MoveCount)
+	require.Empty(t, game.PositionHistory)
+	require.Equal(t, "", game.TakebackRequest)
+	require.EqualValues(t, 0, game.TakebackPlies)
+	require.EqualValues(t, 1, game.TakebackCount)
+	require.Equal(t, types.FormatDeadline(ctx.BlockTime().Add(types.DefaultMaxTurnDuration)), game.Deadline)
+	require.Empty(t, keeper.GetAllGameMove(ctx, "1"))
+}
+
+func TestAcceptTakebackOnePly(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	playTwoPliesForTakeback(t, msgServer, ctx)
+	msgServer.RequestTakeback(context, &types.MsgRequestTakeback{
+		Creator:   carol,
+		GameIndex: "1",
+		Plies:     1,
+	})
+	_, err := msgServer.AcceptTakeback(context, &types.MsgAcceptTakeback{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.Equal(t, "*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*", game.Board)
+	require.Equal(t, "r", game.Turn)
+	require.EqualValues(t, 1, game.MoveCount)
+	require.Equal(t, []string{"*b*b*b*b|b*b*b*b*|***b*b*b|**b*****|********|r*r*r*r*|*r*r*r*r|r*r*r*r*|r"}, game.PositionHistory)
+	require.Len(t, keeper.GetAllGameMove(ctx, "1"), 1)
+}
+
+func TestAcceptTakebackChargesTheClockOfThePlayerToMove(t *testing.T) {
+	msgServer, keeper, context, ctrl := setupMsgServerWithOneClockGameForPlayMove(t)
+	defer ctrl.Finish()
+	start := sdk.UnwrapSDKContext(context).BlockTime()
+	ctx1 := sdk.UnwrapSDKContext(context).WithBlockTime(start.Add(time.Minute))
+	_, err := msgServer.PlayMove(sdk.WrapSDKContext(ctx1), &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     1,
+		FromY:     2,
+		ToX:       2,
+		ToY:       3,
+	})
+	require.Nil(t, err)
+	ctx2 := ctx1.WithBlockTime(start.Add(3 * time.Minute))
+	_, err = msgServer.RequestTakeback(sdk.WrapSDKContext(ctx2), &types.MsgRequestTakeback{
+		Creator:   bob,
+		GameIndex: "1",
+		Plies:     1,
+	})
+	require.Nil(t, err)
+	_, err = msgServer.AcceptTakeback(sdk.WrapSDKContext(ctx2), &types.MsgAcceptTakeback{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	game, _ := keeper.GetStoredGame(ctx2, "1")
+	require.Equal(t, "b", game.Turn)
+	require.Equal(t, 8*time.Minute, game.RedClock)
+	require.Equal(t, 9*time.Minute+5*time.Second, game.BlackClock)
+	require.Equal(t, types.FormatDeadline(ctx2.BlockTime().Add(9*time.Minute+5*time.Second)), game.Deadline)
+}
+
+func TestAcceptTakebackEmitted(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	playTwoPliesForTakeback(t, msgServer, ctx)
+	msgServer.RequestTakeback(context, &types.MsgRequestTakeback{
+		Creator:   bob,
+		GameIndex: "1",
+		Plies:     2,
+	})
+	msgServer.AcceptTakeback(context, &types.MsgAcceptTakeback{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	var accepted []sdk.StringEvent
+	for _, event := range sdk.StringifyEvents(ctx.EventManager().ABCIEvents()) {
+		if event.Type == "takeback-accepted" {
+			accepted = append(accepted, event)
+		}
+	}
+	require.EqualValues(t, []sdk.StringEvent{{
+		Type: "takeback-accepted",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: carol},
+			{Key: "game-index", Value: "1"},
+			{Key: "board", Value: startBoard},
+		},
+	}}, accepted)
+}
+
+func TestAcceptTakebackOwnRequest(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	playTwoPliesForTakeback(t, msgServer, ctx)
+	msgServer.RequestTakeback(context, &types.MsgRequestTakeback{
+		Creator:   carol,
+		GameIndex: "1",
+		Plies:     1,
+	})
+	acceptResponse, err := msgServer.AcceptTakeback(context, &types.MsgAcceptTakeback{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, acceptResponse)
+	require.Equal(t, "player cannot answer their own takeback request", err.Error())
+}
+
+func TestAcceptTakebackNoRequest(t *testing.T) {
+	msgServer, _, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	defer ctrl.Finish()
+	acceptResponse, err := msgServer.AcceptTakeback(context, &types.MsgAcceptTakeback{
+		Creator:   carol,
+		GameIndex: "1",
+	})
+	require.Nil(t, acceptResponse)
+	require.Equal(t, "there is no takeback request to answer", err.Error())
+}
+
+func TestPlayMoveDropsTakebackRequest(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	playTwoPliesForTakeback(t, msgServer, ctx)
+	msgServer.RequestTakeback(context, &types.MsgRequestTakeback{
+		Creator:   carol,
+		GameIndex: "1",
+		Plies:     1,
+	})
+	_, err := msgServer.PlayMove(context, &types.MsgPlayMove{
+		Creator:   bob,
+		GameIndex: "1",
+		FromX:     2,
+		FromY:     3,
+		ToX:       0,
+		ToY:       5,
+	})
+	require.Nil(t, err)
+	game, _ := keeper.GetStoredGame(ctx, "1")
+	require.Equal(t, "", game.TakebackRequest)
+	require.EqualValues(t, 0, game.TakebackPlies)
+}
+
+func TestTakebacksLimitedByParam(t *testing.T) {
+	msgServer, keeper, context, ctrl, _ := setupMsgServerWithOneGameForDraw(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	params := keeper.GetParams(ctx)
+	params.MaxTakebacks = 1
+	keeper.SetParams(ctx, params)
+	playTwoPliesForTakeback(t, msgServer, ctx)
+	msgServer.RequestTakeback(context, &types.MsgRequestTakeback{
+		Creator:   carol,
+		GameIndex: "1",
+		Plies:     1,
+	})
+	_, err := msgServer.AcceptTakeback(context, &types.MsgAcceptTakeback{
+		Creator:   bob,
+		GameIndex: "1",
+	})
+	require.Nil(t, err)
+	requestResponse, err := msgServer.RequestTakeback(context, &types.MsgRequestTakeback{
+		Creator:   bob,
+		GameIndex: "1",
+		Plies:     1,
+	})
+	require.Nil(t, requestResponse)
+	require.Equal(t, "no takebacks left in this game", err.Error())
+}
@@ -0,0 +1,440 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/testutil/sample"
+	"github.com/alice/checkers/x/checkers"
+	"github.com/alice/checkers/x/checkers/keeper"
+	"github.com/alice/checkers/x/checkers/testutil"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMsgServerTournament(t testing.TB) (types.MsgServer, keeper.Keeper, sdk.Context,
+	*gomock.Controller, *testutil.MockBankEscrowKeeper) {
+	ctrl := gomock.NewController(t)
+	bankMock := testutil.NewMockBankEscrowKeeper(ctrl)
+	k, ctx := keepertest.CheckersKeeperWithMocks(t, bankMock)
+	checkers.InitGenesis(ctx, *k, *types.DefaultGenesis())
+	return keeper.NewMsgServerImpl(*k), *k, ctx, ctrl, bankMock
+}
+
+func tournamentStart(ctx sdk.Context) time.Time {
+	return ctx.BlockTime().Add(time.Hour)
+}
+
+// Contexts of the block after the tournament start, and of the one after its first games expired.
+func tournamentContexts(ctx sdk.Context) (started context.Context, expired context.Context) {
+	startedTime := tournamentStart(ctx).Add(time.Second)
+	return sdk.WrapSDKContext(ctx.WithBlockTime(startedTime)),
+		sdk.WrapSDKContext(ctx.WithBlockTime(startedTime.Add(types.DefaultMaxTurnDuration + time.Second)))
+}
+
+// Lets the escrow take any transfer made in the blocks of the tournament, as zero-wager games and
+// zero entry fees still go through the bank. Expectations declared before take precedence.
+func expectAnyTournamentTransfer(escrow *testutil.MockBankEscrowKeeper, ctx sdk.Context) {
+	started, expired := tournamentContexts(ctx)
+	escrow.ExpectAny(sdk.WrapSDKContext(ctx))
+	escrow.ExpectAny(started)
+	escrow.ExpectAny(expired)
+}
+
+func createTournament(msgServer types.MsgServer, ctx sdk.Context, format string, entryFee uint64, players ...string) {
+	context := sdk.WrapSDKContext(ctx)
+	msgServer.CreateTournament(context, &types.MsgCreateTournament{
+		Creator:    alice,
+		Format:     format,
+		EntryFee:   entryFee,
+		MaxPlayers: 4,
+		StartTime:  types.FormatDeadline(tournamentStart(ctx)),
+	})
+	for _, player := range players {
+		_, err := msgServer.JoinTournament(context, &types.MsgJoinTournament{
+			Creator:         player,
+			TournamentIndex: "1",
+		})
+		if err != nil {
+			panic(err.Error())
+		}
+	}
+}
+
+func TestCreateTournament(t *testing.T) {
+	msgServer, keeper, ctx, ctrl, _ := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	context := sdk.WrapSDKContext(ctx)
+	response, err := msgServer.CreateTournament(context, &types.MsgCreateTournament{
+		Creator:    alice,
+		Format:     types.TournamentFormatRoundRobin,
+		EntryFee:   10,
+		MaxPlayers: 4,
+		StartTime:  types.FormatDeadline(tournamentStart(ctx)),
+	})
+	require.Nil(t, err)
+	require.EqualValues(t, types.MsgCreateTournamentResponse{
+		TournamentIndex: "1",
+	}, *response)
+
+	tournament, found := keeper.GetTournament(ctx, "1")
+	require.True(t, found)
+	require.EqualValues(t, types.Tournament{
+		Index:      "1",
+		Creator:    alice,
+		Format:     types.TournamentFormatRoundRobin,
+		EntryFee:   10,
+		MaxPlayers: 4,
+		StartTime:  types.FormatDeadline(tournamentStart(ctx)),
+		Status:     types.TournamentStatusOpen,
+	}, tournament)
+
+	systemInfo, found := keeper.GetSystemInfo(ctx)
+	require.True(t, found)
+	require.EqualValues(t, 2, systemInfo.NextId)
+
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 1)
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "tournament-created",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: alice},
+			{Key: "tournament-index", Value: "1"},
+			{Key: "format", Value: types.TournamentFormatRoundRobin},
+			{Key: "entry-fee", Value: "10"},
+			{Key: "start-time", Value: types.FormatDeadline(tournamentStart(ctx))},
+		},
+	}, events[0])
+}
+
+func TestCreateTournamentStartPassed(t *testing.T) {
+	msgServer, _, ctx, ctrl, _ := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	startTime := types.FormatDeadline(ctx.BlockTime())
+	response, err := msgServer.CreateTournament(sdk.WrapSDKContext(ctx), &types.MsgCreateTournament{
+		Creator:    alice,
+		Format:     types.TournamentFormatRoundRobin,
+		MaxPlayers: 4,
+		StartTime:  startTime,
+	})
+	require.Nil(t, response)
+	require.Equal(t, startTime+": start time has already passed: %s", err.Error())
+}
+
+func TestCreateTournamentDenomNotAllowed(t *testing.T) {
+	msgServer, _, ctx, ctrl, _ := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	response, err := msgServer.CreateTournament(sdk.WrapSDKContext(ctx), &types.MsgCreateTournament{
+		Creator:    alice,
+		Format:     types.TournamentFormatRoundRobin,
+		Denom:      "ibc/unknown",
+		MaxPlayers: 4,
+		StartTime:  types.FormatDeadline(tournamentStart(ctx)),
+	})
+	require.Nil(t, response)
+	require.Equal(t, "ibc/unknown: denomination not allowed for wagers: %s", err.Error())
+}
+
+func TestJoinTournamentEscrowsEntryFee(t *testing.T) {
+	msgServer, keeper, ctx, ctrl, escrow := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	escrow.ExpectPay(sdk.WrapSDKContext(ctx), bob, 10).Times(1)
+	escrow.ExpectPay(sdk.WrapSDKContext(ctx), carol, 10).Times(1)
+	createTournament(msgServer, ctx, types.TournamentFormatSingleElimination, 10, bob, carol)
+
+	tournament, found := keeper.GetTournament(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, []string{bob, carol}, tournament.Players)
+
+	events := sdk.StringifyEvents(ctx.EventManager().ABCIEvents())
+	require.EqualValues(t, sdk.StringEvent{
+		Type: "tournament-joined",
+		Attributes: []sdk.Attribute{
+			{Key: "creator", Value: bob},
+			{Key: "tournament-index", Value: "1"},
+			{Key: "creator", Value: carol},
+			{Key: "tournament-index", Value: "1"},
+		},
+	}, events[1])
+}
+
+func TestJoinTournamentTwice(t *testing.T) {
+	msgServer, _, ctx, ctrl, escrow := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	expectAnyTournamentTransfer(escrow, ctx)
+	createTournament(msgServer, ctx, types.TournamentFormatSingleElimination, 0, bob)
+	response, err := msgServer.JoinTournament(sdk.WrapSDKContext(ctx), &types.MsgJoinTournament{
+		Creator:         bob,
+		TournamentIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "player already joined the tournament", err.Error())
+}
+
+func TestJoinTournamentFull(t *testing.T) {
+	msgServer, _, ctx, ctrl, escrow := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	expectAnyTournamentTransfer(escrow, ctx)
+	createTournament(msgServer, ctx, types.TournamentFormatSingleElimination, 0, alice, bob, carol, sample.AccAddress())
+	response, err := msgServer.JoinTournament(sdk.WrapSDKContext(ctx), &types.MsgJoinTournament{
+		Creator:         sample.AccAddress(),
+		TournamentIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "tournament has no seat left", err.Error())
+}
+
+func TestJoinTournamentUnknown(t *testing.T) {
+	msgServer, _, ctx, ctrl, _ := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	response, err := msgServer.JoinTournament(sdk.WrapSDKContext(ctx), &types.MsgJoinTournament{
+		Creator:         bob,
+		TournamentIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "1: tournament by id not found", err.Error())
+}
+
+func TestJoinTournamentStarted(t *testing.T) {
+	msgServer, keeper, ctx, ctrl, escrow := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	expectAnyTournamentTransfer(escrow, ctx)
+	createTournament(msgServer, ctx, types.TournamentFormatSingleElimination, 0, alice, bob)
+	started, _ := tournamentContexts(ctx)
+	keeper.StartTournaments(started)
+	response, err := msgServer.JoinTournament(started, &types.MsgJoinTournament{
+		Creator:         carol,
+		TournamentIndex: "1",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "tournament is not open to players", err.Error())
+}
+
+func TestStartTournamentWaitsForStartTime(t *testing.T) {
+	msgServer, keeper, ctx, ctrl, escrow := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	expectAnyTournamentTransfer(escrow, ctx)
+	createTournament(msgServer, ctx, types.TournamentFormatSingleElimination, 0, alice, bob)
+	keeper.StartTournaments(sdk.WrapSDKContext(ctx.WithBlockTime(tournamentStart(ctx))))
+	tournament, _ := keeper.GetTournament(ctx, "1")
+	require.Equal(t, types.TournamentStatusOpen, tournament.Status)
+}
+
+func TestStartTournamentCancelledRefunds(t *testing.T) {
+	msgServer, keeper, ctx, ctrl, escrow := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	started, _ := tournamentContexts(ctx)
+	pay := escrow.ExpectPay(sdk.WrapSDKContext(ctx), bob, 10).Times(1)
+	escrow.ExpectRefund(started, bob, 10).Times(1).After(pay)
+	createTournament(msgServer, ctx, types.TournamentFormatSingleElimination, 10, bob)
+
+	keeper.StartTournaments(started)
+
+	tournament, _ := keeper.GetTournament(ctx, "1")
+	require.Equal(t, types.TournamentStatusCancelled, tournament.Status)
+	require.Empty(t, keeper.GetTournamentIndicesToStart(sdk.UnwrapSDKContext(started)))
+}
+
+func TestStartTournamentPairsFirstRound(t *testing.T) {
+	msgServer, keeper, ctx, ctrl, escrow := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	expectAnyTournamentTransfer(escrow, ctx)
+	createTournament(msgServer, ctx, types.TournamentFormatSingleElimination, 0, alice, bob, carol)
+	started, _ := tournamentContexts(ctx)
+
+	keeper.StartTournaments(started)
+
+	tournament, _ := keeper.GetTournament(ctx, "1")
+	require.Equal(t, types.TournamentStatusRunning, tournament.Status)
+	require.EqualValues(t, 1, tournament.Round)
+	require.EqualValues(t, []types.TournamentGame{
+		{Round: 1, Black: alice, Winner: "b"},
+		{Round: 1, GameIndex: "2", Black: bob, Red: carol, Winner: "*"},
+	}, tournament.Games)
+
+	game, found := keeper.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	require.Equal(t, bob, game.Black)
+	require.Equal(t, carol, game.Red)
+	require.EqualValues(t, 0, game.Wager)
+	require.Equal(t, types.GameStatusActive, game.Status)
+	require.Equal(t, "1", game.TournamentIndex)
+
+	systemInfo, _ := keeper.GetSystemInfo(ctx)
+	require.EqualValues(t, types.SystemInfo{
+		NextId: 3,
+	}, systemInfo)
+}
+
+func TestStartTournamentPairsWhateverTheParamsBecame(t *testing.T) {
+	msgServer, keeper, ctx, ctrl, escrow := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	expectAnyTournamentTransfer(escrow, ctx)
+	createTournament(msgServer, ctx, types.TournamentFormatSingleElimination, 0, alice, bob)
+	params := keeper.GetParams(ctx)
+	params.AllowedDenoms = []string{"ibc/ATOM"}
+	params.MinTimeControl = params.MaxTimeControl + time.Second
+	keeper.SetParams(ctx, params)
+	started, _ := tournamentContexts(ctx)
+
+	keeper.StartTournaments(started)
+
+	tournament, _ := keeper.GetTournament(ctx, "1")
+	require.Equal(t, types.TournamentStatusRunning, tournament.Status)
+	game, found := keeper.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	require.Equal(t, types.GameStatusActive, game.Status)
+	require.Equal(t, types.DefaultMaxTurnDuration, game.TimeControl.TurnDuration)
+}
+
+func TestStartTournamentFailedRefundLeftOpen(t *testing.T) {
+	msgServer, keeper, ctx, ctrl, escrow := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	started, _ := tournamentContexts(ctx)
+	pay := escrow.ExpectPay(sdk.WrapSDKContext(ctx), bob, 10).Times(1)
+	escrow.ExpectRefund(started, bob, 10).Times(1).After(pay).Return(errors.New("Oops"))
+	createTournament(msgServer, ctx, types.TournamentFormatSingleElimination, 10, bob)
+
+	keeper.StartTournaments(started)
+
+	tournament, _ := keeper.GetTournament(ctx, "1")
+	require.Equal(t, types.TournamentStatusOpen, tournament.Status)
+	require.Equal(t, []string{"1"}, keeper.GetTournamentIndicesToStart(sdk.UnwrapSDKContext(started)))
+	for _, event := range sdk.StringifyEvents(sdk.UnwrapSDKContext(started).EventManager().ABCIEvents()) {
+		require.NotEqual(t, "tournament-cancelled", event.Type)
+	}
+}
+
+func TestTournamentGameCannotBeRejected(t *testing.T) {
+	msgServer, keeper, ctx, ctrl, escrow := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	expectAnyTournamentTransfer(escrow, ctx)
+	createTournament(msgServer, ctx, types.TournamentFormatSingleElimination, 0, alice, bob)
+	started, _ := tournamentContexts(ctx)
+	keeper.StartTournaments(started)
+	response, err := msgServer.RejectGame(started, &types.MsgRejectGame{
+		Creator:   alice,
+		GameIndex: "2",
+	})
+	require.Nil(t, response)
+	require.Equal(t, "tournament games cannot be rejected", err.Error())
+}
+
+func TestTournamentNextRoundAfterForfeit(t *testing.T) {
+	msgServer, keeper, ctx, ctrl, escrow := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	expectAnyTournamentTransfer(escrow, ctx)
+	createTournament(msgServer, ctx, types.TournamentFormatSingleElimination, 0, alice, bob, carol)
+	started, expired := tournamentContexts(ctx)
+	keeper.StartTournaments(started)
+
+	// Bob, to play first, never does.
+	keeper.ForfeitExpiredGames(expired)
+
+	game, found := keeper.GetStoredGame(ctx, "2")
+	require.True(t, found)
+	require.Equal(t, "r", game.Winner)
+	tournament, _ := keeper.GetTournament(ctx, "1")
+	require.EqualValues(t, 2, tournament.Round)
+	require.EqualValues(t, types.TournamentGame{
+		Round: 2, GameIndex: "3", Black: alice, Red: carol, Winner: "*",
+	}, tournament.Games[2])
+	nextGame, found := keeper.GetStoredGame(ctx, "3")
+	require.True(t, found)
+	require.Equal(t, "1", nextGame.TournamentIndex)
+	systemInfo, _ := keeper.GetSystemInfo(ctx)
+	require.EqualValues(t, types.SystemInfo{
+		NextId: 4,
+	}, systemInfo)
+}
+
+func TestTournamentFinishedPaysWinner(t *testing.T) {
+	msgServer, keeper, ctx, ctrl, escrow := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	started, expired := tournamentContexts(ctx)
+	pay := escrow.ExpectPay(sdk.WrapSDKContext(ctx), alice, 10).Times(1)
+	escrow.ExpectPay(sdk.WrapSDKContext(ctx), bob, 10).Times(1)
+	escrow.ExpectRefund(expired, bob, 20).Times(1).After(pay)
+	expectAnyTournamentTransfer(escrow, ctx)
+	createTournament(msgServer, ctx, types.TournamentFormatSingleElimination, 10, alice, bob)
+	keeper.StartTournaments(started)
+
+	keeper.ForfeitExpiredGames(expired)
+
+	tournament, _ := keeper.GetTournament(ctx, "1")
+	require.Equal(t, types.TournamentStatusFinished, tournament.Status)
+	require.Equal(t, []string{bob}, tournament.Winners)
+	var finished []sdk.StringEvent
+	for _, event := range sdk.StringifyEvents(sdk.UnwrapSDKContext(expired).EventManager().ABCIEvents()) {
+		if event.Type == "tournament-finished" {
+			finished = append(finished, event)
+		}
+	}
+	require.EqualValues(t, []sdk.StringEvent{{
+		Type: "tournament-finished",
+		Attributes: []sdk.Attribute{
+			{Key: "tournament-index", Value: "1"},
+			{Key: "winner", Value: bob},
+			{Key: "prize", Value: "20stake"},
+		},
+	}}, finished)
+}
+
+func TestTournamentFailedPrizeStillForfeits(t *testing.T) {
+	msgServer, keeper, ctx, ctrl, escrow := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	started, expired := tournamentContexts(ctx)
+	pay := escrow.ExpectPay(sdk.WrapSDKContext(ctx), alice, 10).Times(1)
+	escrow.ExpectPay(sdk.WrapSDKContext(ctx), bob, 10).Times(1)
+	escrow.ExpectRefund(expired, bob, 20).Times(1).After(pay).Return(errors.New("Oops"))
+	expectAnyTournamentTransfer(escrow, ctx)
+	createTournament(msgServer, ctx, types.TournamentFormatSingleElimination, 10, alice, bob)
+	keeper.StartTournaments(started)
+
+	keeper.ForfeitExpiredGames(expired)
+
+	game, _ := keeper.GetStoredGame(ctx, "2")
+	require.Equal(t, types.GameStatusFinished, game.Status)
+	require.True(t, game.Forfeited)
+	tournament, _ := keeper.GetTournament(ctx, "1")
+	require.Equal(t, types.TournamentStatusRunning, tournament.Status)
+	require.Equal(t, "*", tournament.Games[0].Winner)
+	require.Empty(t, tournament.Winners)
+}
+
+func TestTournamentDrawnPrizeSplit(t *testing.T) {
+	msgServer, keeper, ctx, ctrl, escrow := setupMsgServerTournament(t)
+	defer ctrl.Finish()
+	started, _ := tournamentContexts(ctx)
+	pay := escrow.ExpectPay(sdk.WrapSDKContext(ctx), alice, 5).Times(1)
+	escrow.ExpectPay(sdk.WrapSDKContext(ctx), bob, 5).Times(1)
+	escrow.ExpectPay(sdk.WrapSDKContext(ctx), carol, 5).Times(1)
+	escrow.ExpectRefund(started, alice, 5).Times(1).After(pay)
+	escrow.ExpectRefund(started, bob, 5).Times(1).After(pay)
+	escrow.ExpectRefund(started, carol, 5).Times(1).After(pay)
+	expectAnyTournamentTransfer(escrow, ctx)
+	createTournament(msgServer, ctx, types.TournamentFormatRoundRobin, 5, alice, bob, carol)
+	keeper.StartTournaments(started)
+
+	// Each round, the two players who meet draw, so they all end with the same points.
+	for round := 0; round < 3; round++ {
+		tournament, _ := keeper.GetTournament(ctx, "1")
+		game := tournament.Games[len(tournament.Games)-1]
+		if game.Red == "" {
+			game = tournament.Games[len(tournament.Games)-2]
+		}
+		_, err := msgServer.OfferDraw(started, &types.MsgOfferDraw{Creator: game.Black, GameIndex: game.GameIndex})
+		require.Nil(t, err)
+		_, err = msgServer.AcceptDraw(started, &types.MsgAcceptDraw{Creator: game.Red, GameIndex: game.GameIndex})
+		require.Nil(t, err)
+	}
+
+	tournament, _ := keeper.GetTournament(ctx, "1")
+	require.Equal(t, types.TournamentStatusFinished, tournament.Status)
+	require.Equal(t, []string{alice, bob, carol}, tournament.Winners)
+}
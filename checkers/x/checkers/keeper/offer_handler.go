@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Checks that the creator can answer the pending offer of a game, given as the color of the player
+// who made it, be it a draw, a takeback or a rematch. Only the opponent of that player can answer
+// it. The same address may play both sides, in which case it can answer itself.
+func checkOfferToAnswer(storedGame types.StoredGame, creator string, offer string, errNoOffer error, errOwnOffer error) error {
+	isBlack := storedGame.Black == creator
+	isRed := storedGame.Red == creator
+	if !isBlack && !isRed {
+		return sdkerrors.Wrapf(types.ErrCreatorNotPlayer, "%s", creator)
+	}
+	if offer == "" {
+		return errNoOffer
+	}
+	offeredByBlack := offer == rules.PieceStrings[rules.BLACK_PLAYER]
+	if (offeredByBlack && !isRed) || (!offeredByBlack && !isBlack) {
+		return errOwnOffer
+	}
+	return nil
+}
@@ -1,16 +1,117 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GetParams get all parameters as types.Params
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams()
+	return types.NewParams(
+		k.AllowedDenoms(ctx),
+		k.MaxTurnDuration(ctx),
+		k.CreateGameGas(ctx),
+		k.PlayMoveGas(ctx),
+		k.RejectGameRefundGas(ctx),
+		k.MinTimeControl(ctx),
+		k.MaxTimeControl(ctx),
+		k.LeaderboardLength(ctx),
+		k.LeaderboardRefreshInterval(ctx),
+		k.MaxTakebacks(ctx),
+		k.MaxMovesWithoutProgress(ctx),
+		k.MaxChallengeDuration(ctx),
+		k.MaxForfeitsPerBlock(ctx),
+		k.MaxChallengeExpiriesPerBlock(ctx),
+	)
 }
 
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
+
+// AllowedDenoms returns the AllowedDenoms param
+func (k Keeper) AllowedDenoms(ctx sdk.Context) (res []string) {
+	k.paramstore.Get(ctx, types.KeyAllowedDenoms, &res)
+	return
+}
+
+// MaxTurnDuration returns the MaxTurnDuration param
+func (k Keeper) MaxTurnDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyMaxTurnDuration, &res)
+	return
+}
+
+// CreateGameGas returns the CreateGameGas param
+func (k Keeper) CreateGameGas(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyCreateGameGas, &res)
+	return
+}
+
+// PlayMoveGas returns the PlayMoveGas param
+func (k Keeper) PlayMoveGas(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyPlayMoveGas, &res)
+	return
+}
+
+// RejectGameRefundGas returns the RejectGameRefundGas param
+func (k Keeper) RejectGameRefundGas(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyRejectGameRefundGas, &res)
+	return
+}
+
+// MinTimeControl returns the MinTimeControl param
+func (k Keeper) MinTimeControl(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyMinTimeControl, &res)
+	return
+}
+
+// MaxTimeControl returns the MaxTimeControl param
+func (k Keeper) MaxTimeControl(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyMaxTimeControl, &res)
+	return
+}
+
+// LeaderboardLength returns the LeaderboardLength param
+func (k Keeper) LeaderboardLength(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyLeaderboardLength, &res)
+	return
+}
+
+// LeaderboardRefreshInterval returns the LeaderboardRefreshInterval param
+func (k Keeper) LeaderboardRefreshInterval(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyLeaderboardRefreshInterval, &res)
+	return
+}
+
+// MaxTakebacks returns the MaxTakebacks param
+func (k Keeper) MaxTakebacks(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxTakebacks, &res)
+	return
+}
+
+// MaxMovesWithoutProgress returns the MaxMovesWithoutProgress param
+func (k Keeper) MaxMovesWithoutProgress(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxMovesWithoutProgress, &res)
+	return
+}
+
+// MaxChallengeDuration returns the MaxChallengeDuration param
+func (k Keeper) MaxChallengeDuration(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.KeyMaxChallengeDuration, &res)
+	return
+}
+
+// MaxForfeitsPerBlock returns the MaxForfeitsPerBlock param
+func (k Keeper) MaxForfeitsPerBlock(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxForfeitsPerBlock, &res)
+	return
+}
+
+// MaxChallengeExpiriesPerBlock returns the MaxChallengeExpiriesPerBlock param
+func (k Keeper) MaxChallengeExpiriesPerBlock(ctx sdk.Context) (res uint64) {
+	k.paramstore.Get(ctx, types.KeyMaxChallengeExpiriesPerBlock, &res)
+	return
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	testkeeper "github.com/alice/checkers/testutil/keeper"
 	"github.com/alice/checkers/x/checkers/types"
@@ -16,3 +17,35 @@ func TestGetParams(t *testing.T) {
 
 	require.EqualValues(t, params, k.GetParams(ctx))
 }
+
+func TestGetParamsAllowedDenoms(t *testing.T) {
+	k, ctx := testkeeper.CheckersKeeper(t)
+	params := types.NewParams([]string{"stake", "ibc/ATOM"}, types.DefaultMaxTurnDuration, types.DefaultCreateGameGas, types.DefaultPlayMoveGas, types.DefaultRejectGameRefundGas, types.DefaultMinTimeControl, types.DefaultMaxTimeControl, types.DefaultLeaderboardLength, types.DefaultLeaderboardRefreshInterval, types.DefaultMaxTakebacks, types.DefaultMaxMovesWithoutProgress, types.DefaultMaxChallengeDuration, types.DefaultMaxForfeitsPerBlock, types.DefaultMaxChallengeExpiriesPerBlock)
+
+	k.SetParams(ctx, params)
+
+	require.EqualValues(t, []string{"stake", "ibc/ATOM"}, k.AllowedDenoms(ctx))
+	require.True(t, k.GetParams(ctx).IsAllowedDenom("ibc/ATOM"))
+	require.False(t, k.GetParams(ctx).IsAllowedDenom("token"))
+}
+
+func TestGetParamsTimingAndGas(t *testing.T) {
+	k, ctx := testkeeper.CheckersKeeper(t)
+	params := types.NewParams([]string{"stake"}, time.Hour, 1, 2, 3, time.Minute, 2*time.Hour, 10, 20, 4, 50, 3*time.Hour, 5, 6)
+
+	k.SetParams(ctx, params)
+
+	require.Equal(t, time.Hour, k.MaxTurnDuration(ctx))
+	require.EqualValues(t, 1, k.CreateGameGas(ctx))
+	require.EqualValues(t, 2, k.PlayMoveGas(ctx))
+	require.EqualValues(t, 3, k.RejectGameRefundGas(ctx))
+	require.Equal(t, time.Minute, k.MinTimeControl(ctx))
+	require.Equal(t, 2*time.Hour, k.MaxTimeControl(ctx))
+	require.EqualValues(t, 10, k.LeaderboardLength(ctx))
+	require.EqualValues(t, 20, k.LeaderboardRefreshInterval(ctx))
+	require.EqualValues(t, 4, k.MaxTakebacks(ctx))
+	require.EqualValues(t, 50, k.MaxMovesWithoutProgress(ctx))
+	require.Equal(t, 3*time.Hour, k.MaxChallengeDuration(ctx))
+	require.EqualValues(t, 5, k.MaxForfeitsPerBlock(ctx))
+	require.EqualValues(t, 6, k.MaxChallengeExpiriesPerBlock(ctx))
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) addToRatingIndex(ctx sdk.Context, playerInfo types.PlayerInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerInfoByRatingKeyPrefix))
+	store.Set(types.PlayerInfoByRatingKey(playerInfo), []byte(playerInfo.Index))
+}
+
+func (k Keeper) removeFromRatingIndex(ctx sdk.Context, playerInfo types.PlayerInfo) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerInfoByRatingKeyPrefix))
+	store.Delete(types.PlayerInfoByRatingKey(playerInfo))
+}
+
+// GetBestPlayerInfos returns up to count players, best rating first, then most wins. Those tied
+// with the last one are returned too, as they compete for its place.
+func (k Keeper) GetBestPlayerInfos(ctx sdk.Context, count uint64) (list []types.PlayerInfo) {
+	if count == 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PlayerInfoByRatingKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		playerInfo, found := k.GetPlayerInfo(ctx, string(iterator.Value()))
+		if !found {
+			panic("Rated player not found " + string(iterator.Value()))
+		}
+		if count <= uint64(len(list)) {
+			last := list[len(list)-1]
+			if playerInfo.EloRating != last.EloRating || playerInfo.WonCount != last.WonCount {
+				break
+			}
+		}
+		list = append(list, playerInfo)
+	}
+
+	return
+}
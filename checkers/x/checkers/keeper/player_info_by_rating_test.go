@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBestPlayerInfosOrdered(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, EloRating: 1184})
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: bob, EloRating: 1216, WonCount: 1})
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: carol, EloRating: 1216, WonCount: 2})
+	require.Equal(t, []types.PlayerInfo{
+		{Index: carol, EloRating: 1216, WonCount: 2},
+		{Index: bob, EloRating: 1216, WonCount: 1},
+	}, keeper.GetBestPlayerInfos(ctx, 2))
+}
+
+func TestGetBestPlayerInfosFollowsRating(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, EloRating: 1184})
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: bob, EloRating: 1216})
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: bob, EloRating: 1100})
+	require.Equal(t, []types.PlayerInfo{
+		{Index: alice, EloRating: 1184},
+		{Index: bob, EloRating: 1100},
+	}, keeper.GetBestPlayerInfos(ctx, 10))
+
+	keeper.RemovePlayerInfo(ctx, alice)
+	require.Equal(t, []types.PlayerInfo{
+		{Index: bob, EloRating: 1100},
+	}, keeper.GetBestPlayerInfos(ctx, 10))
+}
+
+func TestGetBestPlayerInfosKeepsTiesWithLast(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: alice, EloRating: 1216})
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: bob, EloRating: 1200})
+	keeper.SetPlayerInfo(ctx, types.PlayerInfo{Index: carol, EloRating: 1200})
+	require.Len(t, keeper.GetBestPlayerInfos(ctx, 2), 3)
+	require.Len(t, keeper.GetBestPlayerInfos(ctx, 1), 1)
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k *Keeper) getPlayerInfoOrNew(ctx sdk.Context, address string) types.PlayerInfo {
+	playerInfo, found := k.GetPlayerInfo(ctx, address)
+	if !found {
+		return types.NewPlayerInfo(address)
+	}
+	return playerInfo
+}
+
+// MustRegisterGameResult updates the record and the rating of both players of a game that just
+// ended, where forfeited tells whether the loser ran out of time. A player who played both sides
+// has nothing to record.
+func (k *Keeper) MustRegisterGameResult(ctx sdk.Context, storedGame *types.StoredGame, forfeited bool) {
+	if storedGame.Winner == rules.PieceStrings[rules.NO_PLAYER] {
+		panic(types.ErrGameNotFinished.Error())
+	}
+	if storedGame.Black == storedGame.Red {
+		return
+	}
+	black := k.getPlayerInfoOrNew(ctx, storedGame.Black)
+	red := k.getPlayerInfoOrNew(ctx, storedGame.Red)
+
+	blackScore := sdk.NewDecWithPrec(5, 1)
+	switch storedGame.Winner {
+	case rules.PieceStrings[rules.DRAW_PLAYER]:
+		black.DrawnCount++
+		red.DrawnCount++
+	case rules.PieceStrings[rules.BLACK_PLAYER]:
+		blackScore = sdk.OneDec()
+		black.WonCount++
+		if forfeited {
+			red.ForfeitedCount++
+		} else {
+			red.LostCount++
+		}
+	default:
+		blackScore = sdk.ZeroDec()
+		red.WonCount++
+		if forfeited {
+			black.ForfeitedCount++
+		} else {
+			black.LostCount++
+		}
+	}
+	black.PlayedCount++
+	red.PlayedCount++
+
+	// Both ratings change based on the ones before the game.
+	black.EloRating, red.EloRating =
+		types.NewEloRating(black.EloRating, red.EloRating, blackScore),
+		types.NewEloRating(red.EloRating, black.EloRating, sdk.OneDec().Sub(blackScore))
+	k.SetPlayerInfo(ctx, black)
+	k.SetPlayerInfo(ctx, red)
+	k.SetLeaderboardCandidate(ctx, black.Index)
+	k.SetLeaderboardCandidate(ctx, red.Index)
+}
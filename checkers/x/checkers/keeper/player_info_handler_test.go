@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterGameResultWin(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	keeper.MustRegisterGameResult(ctx, &types.StoredGame{Black: bob, Red: carol, Winner: "r"}, false)
+	bobInfo, _ := keeper.GetPlayerInfo(ctx, bob)
+	carolInfo, _ := keeper.GetPlayerInfo(ctx, carol)
+	require.EqualValues(t, types.PlayerInfo{Index: bob, LostCount: 1, PlayedCount: 1, EloRating: 1184}, bobInfo)
+	require.EqualValues(t, types.PlayerInfo{Index: carol, WonCount: 1, PlayedCount: 1, EloRating: 1216}, carolInfo)
+}
+
+func TestRegisterGameResultForfeit(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	keeper.MustRegisterGameResult(ctx, &types.StoredGame{Black: bob, Red: carol, Winner: "b"}, true)
+	bobInfo, _ := keeper.GetPlayerInfo(ctx, bob)
+	carolInfo, _ := keeper.GetPlayerInfo(ctx, carol)
+	require.EqualValues(t, types.PlayerInfo{Index: bob, WonCount: 1, PlayedCount: 1, EloRating: 1216}, bobInfo)
+	require.EqualValues(t, types.PlayerInfo{Index: carol, ForfeitedCount: 1, PlayedCount: 1, EloRating: 1184}, carolInfo)
+}
+
+func TestRegisterGameResultDrawAfterWin(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	keeper.MustRegisterGameResult(ctx, &types.StoredGame{Black: bob, Red: carol, Winner: "b"}, false)
+	keeper.MustRegisterGameResult(ctx, &types.StoredGame{Black: carol, Red: bob, Winner: "d"}, false)
+	bobInfo, _ := keeper.GetPlayerInfo(ctx, bob)
+	carolInfo, _ := keeper.GetPlayerInfo(ctx, carol)
+	// The draw favours carol, who was rated lower.
+	require.EqualValues(t, types.PlayerInfo{Index: bob, WonCount: 1, DrawnCount: 1, PlayedCount: 2, EloRating: 1215}, bobInfo)
+	require.EqualValues(t, types.PlayerInfo{Index: carol, LostCount: 1, DrawnCount: 1, PlayedCount: 2, EloRating: 1185}, carolInfo)
+}
+
+func TestRegisterGameResultAgainstSelf(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	keeper.MustRegisterGameResult(ctx, &types.StoredGame{Black: bob, Red: bob, Winner: "b"}, false)
+	_, found := keeper.GetPlayerInfo(ctx, bob)
+	require.False(t, found)
+}
+
+func TestRegisterGameResultNotFinished(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	defer func() {
+		r := recover()
+		require.NotNil(t, r, "The code did not panic")
+		require.Equal(t, "game is not finished, there is no result to register", r)
+	}()
+	keeper.MustRegisterGameResult(ctx, &types.StoredGame{Black: bob, Red: carol, Winner: "*"}, false)
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Fetches the finished game whose pending rematch offer the creator wants to accept.
+func (k Keeper) getRematchOfferToAnswer(ctx sdk.Context, gameIndex string, creator string) (storedGame types.StoredGame, err error) {
+	storedGame, found := k.GetStoredGame(ctx, gameIndex)
+	if !found {
+		return storedGame, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", gameIndex)
+	}
+	if storedGame.Status != types.GameStatusFinished {
+		return storedGame, types.ErrRematchGameNotFinished
+	}
+	if err := checkOfferToAnswer(storedGame, creator, storedGame.RematchOffer, types.ErrNoRematchOffer, types.ErrCannotAnswerOwnRematch); err != nil {
+		return storedGame, err
+	}
+	return storedGame, nil
+}
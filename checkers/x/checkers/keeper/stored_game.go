@@ -8,6 +8,23 @@ import (
 
 // SetStoredGame set a specific storedGame in the store from its index
 func (k Keeper) SetStoredGame(ctx sdk.Context, storedGame types.StoredGame) {
+	// The deadline index follows the game, whatever its deadline was before.
+	previous, found := k.GetStoredGame(ctx, storedGame.Index)
+	if found {
+		k.removeFromDeadlineIndex(ctx, previous)
+	}
+	k.addToDeadlineIndex(ctx, storedGame)
+	if !found || previous.Black != storedGame.Black || previous.Red != storedGame.Red {
+		if found {
+			k.removeFromPlayerIndex(ctx, previous)
+		}
+		k.addToPlayerIndex(ctx, storedGame)
+	}
+	if found {
+		k.updateListingIndex(ctx, &previous, &storedGame)
+	} else {
+		k.updateListingIndex(ctx, nil, &storedGame)
+	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StoredGameKeyPrefix))
 	b := k.cdc.MustMarshal(&storedGame)
 	store.Set(types.StoredGameKey(
@@ -40,6 +57,11 @@ func (k Keeper) RemoveStoredGame(
 	index string,
 
 ) {
+	if previous, found := k.GetStoredGame(ctx, index); found {
+		k.removeFromDeadlineIndex(ctx, previous)
+		k.removeFromPlayerIndex(ctx, previous)
+		k.updateListingIndex(ctx, &previous, nil)
+	}
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StoredGameKeyPrefix))
 	store.Delete(types.StoredGameKey(
 		index,
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Only games that are not over can be forfeited, so they are the only ones in the deadline index.
+// A game whose deadline cannot be parsed is left out, it could not expire anyway.
+func (k Keeper) addToDeadlineIndex(ctx sdk.Context, storedGame types.StoredGame) {
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return
+	}
+	deadline, err := storedGame.GetDeadlineAsTime()
+	if err != nil {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StoredGameByDeadlineKeyPrefix))
+	store.Set(types.StoredGameByDeadlineKey(deadline, storedGame.Index), []byte(storedGame.Index))
+}
+
+func (k Keeper) removeFromDeadlineIndex(ctx sdk.Context, storedGame types.StoredGame) {
+	deadline, err := storedGame.GetDeadlineAsTime()
+	if err != nil {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StoredGameByDeadlineKeyPrefix))
+	store.Delete(types.StoredGameByDeadlineKey(deadline, storedGame.Index))
+}
+
+// GetExpiredGameIndices returns the indices of the games past their deadline, soonest deadline
+// first, and no more than limit of them.
+func (k Keeper) GetExpiredGameIndices(ctx sdk.Context, limit int) (indices []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StoredGameByDeadlineKeyPrefix))
+	// Games due at the block time itself have not expired yet.
+	iterator := store.Iterator(nil, sdk.FormatTimeBytes(ctx.BlockTime()))
+	defer iterator.Close()
+
+	for ; iterator.Valid() && len(indices) < limit; iterator.Next() {
+		indices = append(indices, string(iterator.Value()))
+	}
+	return
+}
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpiredGameIndicesSoonestFirst(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	now := ctx.BlockTime()
+	keeper.SetStoredGame(ctx, types.StoredGame{Index: "1", Winner: "*", Deadline: types.FormatDeadline(now.Add(-time.Second))})
+	keeper.SetStoredGame(ctx, types.StoredGame{Index: "2", Winner: "*", Deadline: types.FormatDeadline(now.Add(-time.Hour))})
+	keeper.SetStoredGame(ctx, types.StoredGame{Index: "3", Winner: "*", Deadline: types.FormatDeadline(now)})
+	keeper.SetStoredGame(ctx, types.StoredGame{Index: "4", Winner: "*", Deadline: types.FormatDeadline(now.Add(time.Hour))})
+	require.Equal(t, []string{"2", "1"}, keeper.GetExpiredGameIndices(ctx, 10))
+	require.Equal(t, []string{"2"}, keeper.GetExpiredGameIndices(ctx, 1))
+}
+
+func TestExpiredGameIndicesFollowDeadlineChange(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	now := ctx.BlockTime()
+	game := types.StoredGame{Index: "1", Winner: "*", Deadline: types.FormatDeadline(now.Add(-time.Second))}
+	keeper.SetStoredGame(ctx, game)
+	game.Deadline = types.FormatDeadline(now.Add(time.Minute))
+	keeper.SetStoredGame(ctx, game)
+	require.Empty(t, keeper.GetExpiredGameIndices(ctx, 10))
+}
+
+func TestExpiredGameIndicesSkipFinishedAndRemoved(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	deadline := types.FormatDeadline(ctx.BlockTime().Add(-time.Second))
+	keeper.SetStoredGame(ctx, types.StoredGame{Index: "1", Winner: "*", Deadline: deadline})
+	keeper.SetStoredGame(ctx, types.StoredGame{Index: "2", Winner: "*", Deadline: deadline})
+	keeper.SetStoredGame(ctx, types.StoredGame{Index: "1", Winner: "b", Deadline: deadline})
+	keeper.RemoveStoredGame(ctx, "2")
+	require.Empty(t, keeper.GetExpiredGameIndices(ctx, 10))
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// A player left empty, as on an open challenge not yet accepted, is not indexed.
+func (k Keeper) addToPlayerIndex(ctx sdk.Context, storedGame types.StoredGame) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StoredGameByPlayerKeyPrefix))
+	for _, player := range []string{storedGame.Black, storedGame.Red} {
+		if player != "" {
+			store.Set(types.StoredGameByPlayerKey(player, storedGame.Index), []byte(storedGame.Index))
+		}
+	}
+}
+
+func (k Keeper) removeFromPlayerIndex(ctx sdk.Context, storedGame types.StoredGame) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StoredGameByPlayerKeyPrefix))
+	for _, player := range []string{storedGame.Black, storedGame.Red} {
+		store.Delete(types.StoredGameByPlayerKey(player, storedGame.Index))
+	}
+}
+
+func (k Keeper) GamesByPlayer(goCtx context.Context, req *types.QueryGamesByPlayerRequest) (*types.QueryGamesByPlayerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Address); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !types.IsListingStatus(req.Status) {
+		return nil, status.Error(codes.InvalidArgument, "unknown game status: "+req.Status)
+	}
+
+	var storedGames []types.StoredGame
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	playerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StoredGameByPlayerKeyPrefix))
+	gameStore := prefix.NewStore(playerStore, types.StoredGameByPlayerPrefix(req.Address))
+
+	pageRes, err := query.FilteredPaginate(gameStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		storedGame, found := k.GetStoredGame(ctx, string(value))
+		if !found {
+			return false, status.Error(codes.Internal, "indexed game not found "+string(value))
+		}
+		if !storedGame.HasListingStatus(req.Status) {
+			return false, nil
+		}
+		if accumulate {
+			storedGames = append(storedGames, storedGame)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGamesByPlayerResponse{StoredGames: storedGames, Pagination: pageRes}, nil
+}
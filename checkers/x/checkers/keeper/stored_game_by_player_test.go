@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func gameIndices(storedGames []types.StoredGame) (indices []string) {
+	for _, storedGame := range storedGames {
+		indices = append(indices, storedGame.Index)
+	}
+	return indices
+}
+
+func TestGamesByPlayerBothColors(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetStoredGame(ctx, types.StoredGame{Index: "1", Black: alice, Red: bob, Status: types.GameStatusActive})
+	keeper.SetStoredGame(ctx, types.StoredGame{Index: "2", Black: bob, Red: carol, Status: types.GameStatusPending})
+	keeper.SetStoredGame(ctx, types.StoredGame{Index: "3", Black: carol, Red: alice, Status: types.GameStatusFinished})
+
+	for player, expected := range map[string][]string{
+		alice: {"1", "3"},
+		bob:   {"1", "2"},
+		carol: {"2", "3"},
+	} {
+		response, err := keeper.GamesByPlayer(wctx, &types.QueryGamesByPlayerRequest{Address: player})
+		require.Nil(t, err)
+		require.Equal(t, expected, gameIndices(response.StoredGames))
+	}
+}
+
+func TestGamesByPlayerStatusFilter(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	keeper.SetStoredGame(ctx, types.StoredGame{Index: "1", Black: alice, Red: bob, Status: types.GameStatusActive})
+	keeper.SetStoredGame(ctx, types.StoredGame{Index: "2", Black: alice, Red: carol, Status: types.GameStatusFinished})
+	keeper.SetStoredGame(ctx, types.StoredGame{Index: "3", Black: bob, Red: alice, Status: types.GameStatusActive})
+	keeper.SetStoredGame(ctx, types.StoredGame{Index: "4", Black: alice, Red: carol, Status: types.GameStatusActive})
+
+	first, err := keeper.GamesByPlayer(wctx, &types.QueryGamesByPlayerRequest{
+		Address:    alice,
+		Status:     types.GameStatusActive,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"1", "3"}, gameIndices(first.StoredGames))
+	require.EqualValues(t, 3, first.Pagination.Total)
+	second, err := keeper.GamesByPlayer(wctx, &types.QueryGamesByPlayerRequest{
+		Address:    alice,
+		Status:     types.GameStatusActive,
+		Pagination: &query.PageRequest{Key: first.Pagination.NextKey, Limit: 2},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"4"}, gameIndices(second.StoredGames))
+}
+
+func TestGamesByPlayerSameAddressBothSides(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	keeper.SetStoredGame(ctx, types.StoredGame{Index: "1", Black: alice, Red: alice})
+	response, err := keeper.GamesByPlayer(sdk.WrapSDKContext(ctx), &types.QueryGamesByPlayerRequest{Address: alice})
+	require.Nil(t, err)
+	require.Equal(t, []string{"1"}, gameIndices(response.StoredGames))
+}
+
+func TestGamesByPlayerRemoved(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	keeper.SetStoredGame(ctx, types.StoredGame{Index: "1", Black: alice, Red: bob})
+	keeper.SetStoredGame(ctx, types.StoredGame{Index: "2", Black: alice, Red: bob})
+	keeper.RemoveStoredGame(ctx, "1")
+	response, err := keeper.GamesByPlayer(sdk.WrapSDKContext(ctx), &types.QueryGamesByPlayerRequest{Address: bob})
+	require.Nil(t, err)
+	require.Equal(t, []string{"2"}, gameIndices(response.StoredGames))
+}
+
+func TestGamesByPlayerInvalidRequest(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	_, err := keeper.GamesByPlayer(wctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	_, err = keeper.GamesByPlayer(wctx, &types.QueryGamesByPlayerRequest{Address: alice, Status: "won"})
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "unknown game status: won"))
+	_, err = keeper.GamesByPlayer(wctx, &types.QueryGamesByPlayerRequest{Address: "notanaddress"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,205 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// A game is listed in each order it can be sorted by, under all games and each of its listing
+// statuses, crossed with either color and its turn, with its index as the value. It is listed
+// again in the wager listing, by creation only, with its wager ahead of the sort key. A move changes
+// the turn and deadline, so it rewrites the entries by deadline and those by turn, which is what
+// listing without scanning costs.
+func (k Keeper) getListingEntries(storedGame *types.StoredGame) (entries map[string][]byte) {
+	entries = map[string][]byte{}
+	if storedGame == nil {
+		return entries
+	}
+	value := []byte(storedGame.Index)
+	statuses := append([]string{""}, storedGame.GetListingStatuses()...)
+	for _, sortBy := range []string{types.GameSortByCreated, types.GameSortByDeadline} {
+		sortKey, found := storedGame.GetListingSortKey(sortBy)
+		if !found {
+			continue
+		}
+		for _, status := range statuses {
+			for _, turn := range storedGame.GetListingTurns() {
+				key := types.StoredGameListingKey(sortBy, status, turn, sortKey, storedGame.Index)
+				entries[string(types.KeyPrefix(types.StoredGameListingKeyPrefix))+string(key)] = value
+			}
+		}
+	}
+	createdKey, found := storedGame.GetListingSortKey(types.GameSortByCreated)
+	if !found {
+		return entries
+	}
+	wagerSortKey := append(sdk.Uint64ToBigEndian(storedGame.Wager), createdKey...)
+	for _, status := range statuses {
+		for _, turn := range storedGame.GetListingTurns() {
+			key := types.StoredGameListingKey(types.GameSortByCreated, status, turn, wagerSortKey, storedGame.Index)
+			entries[string(types.KeyPrefix(types.StoredGameWagerListingKeyPrefix))+string(key)] = value
+		}
+	}
+	return entries
+}
+
+// Moves a game in the listing indices from where it was to where it now is, either of them nil when
+// the game is new or removed. Entries that did not change are left untouched.
+func (k Keeper) updateListingIndex(ctx sdk.Context, previous *types.StoredGame, storedGame *types.StoredGame) {
+	store := ctx.KVStore(k.storeKey)
+	previousEntries := k.getListingEntries(previous)
+	entries := k.getListingEntries(storedGame)
+	for key := range previousEntries {
+		if _, found := entries[key]; !found {
+			store.Delete([]byte(key))
+		}
+	}
+	for key, value := range entries {
+		if previousValue, found := previousEntries[key]; !found || string(previousValue) != string(value) {
+			store.Set([]byte(key), value)
+		}
+	}
+}
+
+func isListingFiltered(req *types.QueryAllStoredGameRequest) bool {
+	return req.Status != "" || isWagerRange(req) || req.Turn != "" || req.SortBy != ""
+}
+
+func hasMaxWager(req *types.QueryAllStoredGameRequest) bool {
+	return req.HasMaxWager || req.MaxWager != 0
+}
+
+func isWagerRange(req *types.QueryAllStoredGameRequest) bool {
+	return req.MinWager != 0 || hasMaxWager(req)
+}
+
+// Lists the games from the listing index of the requested status, turn and order, or from the wager
+// listing within the wager range, so that only the listed games are read.
+func (k Keeper) storedGameListing(ctx sdk.Context, req *types.QueryAllStoredGameRequest) (*types.QueryAllStoredGameResponse, error) {
+	if !types.IsListingStatus(req.Status) {
+		return nil, status.Error(codes.InvalidArgument, "unknown game status: "+req.Status)
+	}
+	switch req.Turn {
+	case "", rules.PieceStrings[rules.BLACK_PLAYER], rules.PieceStrings[rules.RED_PLAYER]:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unknown turn: "+req.Turn)
+	}
+	if hasMaxWager(req) && req.MaxWager < req.MinWager {
+		return nil, status.Error(codes.InvalidArgument, "max wager is below min wager")
+	}
+	sortBy := req.SortBy
+	if sortBy == "" {
+		sortBy = types.GameSortByCreated
+	}
+	if !types.IsListingSortBy(sortBy) {
+		return nil, status.Error(codes.InvalidArgument, "unknown sort order: "+req.SortBy)
+	}
+	if isWagerRange(req) && req.SortBy != "" {
+		return nil, status.Error(codes.InvalidArgument, "games in a wager range are sorted by wager")
+	}
+
+	var storedGames []types.StoredGame
+	onResult := func(key []byte, value []byte) error {
+		storedGame, found := k.GetStoredGame(ctx, string(value))
+		if !found {
+			return status.Error(codes.Internal, "listed game not found "+string(value))
+		}
+		storedGames = append(storedGames, storedGame)
+		return nil
+	}
+
+	var pageRes *query.PageResponse
+	var err error
+	if !isWagerRange(req) {
+		listingStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StoredGameListingKeyPrefix))
+		store := prefix.NewStore(listingStore, types.StoredGameListingPrefix(sortBy, req.Status, req.Turn))
+		pageRes, err = query.Paginate(store, req.Pagination, onResult)
+	} else {
+		listingStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StoredGameWagerListingKeyPrefix))
+		store := prefix.NewStore(listingStore, types.StoredGameListingPrefix(types.GameSortByCreated, req.Status, req.Turn))
+		var end []byte
+		if hasMaxWager(req) && req.MaxWager != math.MaxUint64 {
+			end = sdk.Uint64ToBigEndian(req.MaxWager + 1)
+		}
+		pageRes, err = paginateRange(store, sdk.Uint64ToBigEndian(req.MinWager), end, req.Pagination, onResult)
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllStoredGameResponse{StoredGame: storedGames, Pagination: pageRes}, nil
+}
+
+// paginateRange pages through the keys from start, inclusive, to end, exclusive or nil for no
+// bound, as query.Paginate does through a whole store. A page key resumes where the previous page
+// stopped, inclusive.
+func paginateRange(
+	store sdk.KVStore,
+	start []byte,
+	end []byte,
+	pageRequest *query.PageRequest,
+	onResult func(key []byte, value []byte) error,
+) (*query.PageResponse, error) {
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+	offset := pageRequest.Offset
+	limit := pageRequest.Limit
+	countTotal := pageRequest.CountTotal
+	if offset > 0 && pageRequest.Key != nil {
+		return nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+	if len(pageRequest.Key) != 0 {
+		if pageRequest.Reverse {
+			// The smallest key after the page key, to include it.
+			end = append(append([]byte{}, pageRequest.Key...), 0)
+		} else {
+			start = pageRequest.Key
+		}
+		countTotal = false
+	}
+
+	var iterator sdk.Iterator
+	if pageRequest.Reverse {
+		iterator = store.ReverseIterator(start, end)
+	} else {
+		iterator = store.Iterator(start, end)
+	}
+	defer iterator.Close()
+
+	var count uint64
+	var nextKey []byte
+	for ; iterator.Valid(); iterator.Next() {
+		if count == offset+limit {
+			nextKey = iterator.Key()
+			if !countTotal {
+				break
+			}
+		}
+		if offset <= count && count < offset+limit {
+			if err := onResult(iterator.Key(), iterator.Value()); err != nil {
+				return nil, err
+			}
+		}
+		count++
+	}
+
+	res := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		res.Total = count
+	}
+	return res, nil
+}
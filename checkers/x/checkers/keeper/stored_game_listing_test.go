@@ -0,0 +1,227 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	keepertest "github.com/alice/checkers/testutil/keeper"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func listingGame(index string, status string, turn string, wager uint64, deadline time.Time) types.StoredGame {
+	return types.StoredGame{
+		Index:    index,
+		Black:    alice,
+		Red:      bob,
+		Turn:     turn,
+		Wager:    wager,
+		Status:   status,
+		Winner:   "*",
+		Deadline: types.FormatDeadline(deadline),
+	}
+}
+
+func TestStoredGameListingStatuses(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	now := time.Now()
+	forfeited := listingGame("3", types.GameStatusFinished, "b", 0, now)
+	forfeited.Winner = "r"
+	forfeited.Forfeited = true
+	drawn := listingGame("4", types.GameStatusFinished, "r", 0, now)
+	drawn.Winner = "d"
+	won := listingGame("5", types.GameStatusFinished, "r", 0, now)
+	won.Winner = "b"
+	keeper.SetStoredGame(ctx, listingGame("1", types.GameStatusPending, "b", 0, now))
+	keeper.SetStoredGame(ctx, listingGame("2", types.GameStatusActive, "b", 0, now))
+	keeper.SetStoredGame(ctx, forfeited)
+	keeper.SetStoredGame(ctx, drawn)
+	keeper.SetStoredGame(ctx, won)
+
+	for status, expected := range map[string][]string{
+		types.GameStatusPending:   {"1"},
+		types.GameStatusActive:    {"2"},
+		types.GameStatusFinished:  {"3", "4", "5"},
+		types.GameStatusForfeited: {"3"},
+		types.GameStatusDrawn:     {"4"},
+	} {
+		response, err := keeper.StoredGameAll(wctx, &types.QueryAllStoredGameRequest{Status: status})
+		require.Nil(t, err)
+		require.Equal(t, expected, gameIndices(response.StoredGame), status)
+	}
+}
+
+func TestStoredGameListingWagerAndTurn(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	now := time.Now()
+	keeper.SetStoredGame(ctx, listingGame("1", types.GameStatusActive, "b", 5, now))
+	keeper.SetStoredGame(ctx, listingGame("2", types.GameStatusActive, "r", 10, now))
+	keeper.SetStoredGame(ctx, listingGame("3", types.GameStatusActive, "b", 15, now))
+	keeper.SetStoredGame(ctx, listingGame("4", types.GameStatusActive, "b", 20, now))
+	keeper.SetStoredGame(ctx, listingGame("5", types.GameStatusActive, "b", 25, now))
+
+	response, err := keeper.StoredGameAll(wctx, &types.QueryAllStoredGameRequest{MinWager: 10, MaxWager: 20})
+	require.Nil(t, err)
+	require.Equal(t, []string{"2", "3", "4"}, gameIndices(response.StoredGame))
+
+	first, err := keeper.StoredGameAll(wctx, &types.QueryAllStoredGameRequest{
+		MinWager:   10,
+		Turn:       "b",
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"3", "4"}, gameIndices(first.StoredGame))
+	require.EqualValues(t, 3, first.Pagination.Total)
+	second, err := keeper.StoredGameAll(wctx, &types.QueryAllStoredGameRequest{
+		MinWager:   10,
+		Turn:       "b",
+		Pagination: &query.PageRequest{Key: first.Pagination.NextKey, Limit: 2},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"5"}, gameIndices(second.StoredGame))
+}
+
+func TestStoredGameListingWagerRangeByWagerFirst(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	now := time.Now()
+	keeper.SetStoredGame(ctx, listingGame("1", types.GameStatusActive, "b", 20, now))
+	keeper.SetStoredGame(ctx, listingGame("2", types.GameStatusActive, "b", 10, now.Add(2*time.Minute)))
+	keeper.SetStoredGame(ctx, listingGame("3", types.GameStatusActive, "r", 10, now.Add(time.Minute)))
+	keeper.SetStoredGame(ctx, listingGame("4", types.GameStatusActive, "b", 5, now))
+	keeper.SetStoredGame(ctx, listingGame("5", types.GameStatusActive, "b", math.MaxUint64, now))
+
+	byCreation, err := keeper.StoredGameAll(wctx, &types.QueryAllStoredGameRequest{
+		MinWager: 10,
+		MaxWager: 20,
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"2", "3", "1"}, gameIndices(byCreation.StoredGame))
+	unbounded, err := keeper.StoredGameAll(wctx, &types.QueryAllStoredGameRequest{MinWager: 20, MaxWager: math.MaxUint64})
+	require.Nil(t, err)
+	require.Equal(t, []string{"1", "5"}, gameIndices(unbounded.StoredGame))
+
+	first, err := keeper.StoredGameAll(wctx, &types.QueryAllStoredGameRequest{
+		MinWager:   10,
+		MaxWager:   20,
+		Pagination: &query.PageRequest{Limit: 2, Reverse: true},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"1", "3"}, gameIndices(first.StoredGame))
+	require.EqualValues(t, 0, first.Pagination.Total)
+	second, err := keeper.StoredGameAll(wctx, &types.QueryAllStoredGameRequest{
+		MinWager:   10,
+		MaxWager:   20,
+		Pagination: &query.PageRequest{Key: first.Pagination.NextKey, Limit: 2, Reverse: true},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"2"}, gameIndices(second.StoredGame))
+	require.Nil(t, second.Pagination.NextKey)
+	offset, err := keeper.StoredGameAll(wctx, &types.QueryAllStoredGameRequest{
+		MinWager:   5,
+		Pagination: &query.PageRequest{Offset: 1, Limit: 2, CountTotal: true},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"2", "3"}, gameIndices(offset.StoredGame))
+	require.EqualValues(t, 5, offset.Pagination.Total)
+}
+
+func TestStoredGameListingZeroMaxWager(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	now := time.Now()
+	keeper.SetStoredGame(ctx, listingGame("1", types.GameStatusActive, "b", 10, now))
+	keeper.SetStoredGame(ctx, listingGame("2", types.GameStatusActive, "b", 0, now))
+	keeper.SetStoredGame(ctx, listingGame("3", types.GameStatusActive, "b", 0, now))
+
+	withoutWager, err := keeper.StoredGameAll(wctx, &types.QueryAllStoredGameRequest{HasMaxWager: true})
+	require.Nil(t, err)
+	require.Equal(t, []string{"2", "3"}, gameIndices(withoutWager.StoredGame))
+	unbounded, err := keeper.StoredGameAll(wctx, &types.QueryAllStoredGameRequest{MaxWager: 0, Turn: "b"})
+	require.Nil(t, err)
+	require.Equal(t, []string{"1", "2", "3"}, gameIndices(unbounded.StoredGame))
+}
+
+func TestStoredGameListingSortBy(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	now := time.Now()
+	keeper.SetStoredGame(ctx, listingGame("2", types.GameStatusActive, "b", 0, now.Add(time.Minute)))
+	keeper.SetStoredGame(ctx, listingGame("10", types.GameStatusActive, "b", 0, now))
+	keeper.SetStoredGame(ctx, listingGame("9", types.GameStatusActive, "b", 0, now.Add(2*time.Minute)))
+
+	byCreation, err := keeper.StoredGameAll(wctx, &types.QueryAllStoredGameRequest{SortBy: types.GameSortByCreated})
+	require.Nil(t, err)
+	require.Equal(t, []string{"2", "9", "10"}, gameIndices(byCreation.StoredGame))
+	byDeadline, err := keeper.StoredGameAll(wctx, &types.QueryAllStoredGameRequest{SortBy: types.GameSortByDeadline})
+	require.Nil(t, err)
+	require.Equal(t, []string{"10", "2", "9"}, gameIndices(byDeadline.StoredGame))
+	reversed, err := keeper.StoredGameAll(wctx, &types.QueryAllStoredGameRequest{
+		SortBy:     types.GameSortByDeadline,
+		Pagination: &query.PageRequest{Reverse: true},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []string{"9", "2", "10"}, gameIndices(reversed.StoredGame))
+}
+
+func TestStoredGameListingFollowsGame(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	now := time.Now()
+	keeper.SetStoredGame(ctx, listingGame("1", types.GameStatusActive, "b", 0, now.Add(time.Minute)))
+	keeper.SetStoredGame(ctx, listingGame("2", types.GameStatusActive, "b", 0, now))
+	keeper.SetStoredGame(ctx, listingGame("3", types.GameStatusActive, "b", 0, now.Add(2*time.Minute)))
+	keeper.SetStoredGame(ctx, listingGame("1", types.GameStatusActive, "r", 0, now.Add(3*time.Minute)))
+	keeper.RemoveStoredGame(ctx, "3")
+
+	byDeadline, err := keeper.StoredGameAll(wctx, &types.QueryAllStoredGameRequest{SortBy: types.GameSortByDeadline})
+	require.Nil(t, err)
+	require.Equal(t, []string{"2", "1"}, gameIndices(byDeadline.StoredGame))
+	redToPlay, err := keeper.StoredGameAll(wctx, &types.QueryAllStoredGameRequest{Turn: "r"})
+	require.Nil(t, err)
+	require.Equal(t, []string{"1"}, gameIndices(redToPlay.StoredGame))
+}
+
+func TestStoredGameListingInvalid(t *testing.T) {
+	keeper, ctx := keepertest.CheckersKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	for _, tc := range []struct {
+		request *types.QueryAllStoredGameRequest
+		err     error
+	}{
+		{
+			request: &types.QueryAllStoredGameRequest{Status: "over"},
+			err:     status.Error(codes.InvalidArgument, "unknown game status: over"),
+		},
+		{
+			request: &types.QueryAllStoredGameRequest{Turn: "d"},
+			err:     status.Error(codes.InvalidArgument, "unknown turn: d"),
+		},
+		{
+			request: &types.QueryAllStoredGameRequest{MinWager: 10, MaxWager: 5},
+			err:     status.Error(codes.InvalidArgument, "max wager is below min wager"),
+		},
+		{
+			request: &types.QueryAllStoredGameRequest{SortBy: "wager"},
+			err:     status.Error(codes.InvalidArgument, "unknown sort order: wager"),
+		},
+		{
+			request: &types.QueryAllStoredGameRequest{MinWager: 10, SortBy: types.GameSortByDeadline},
+			err:     status.Error(codes.InvalidArgument, "games in a wager range are sorted by wager"),
+		},
+		{
+			request: &types.QueryAllStoredGameRequest{MinWager: 10, HasMaxWager: true},
+			err:     status.Error(codes.InvalidArgument, "max wager is below min wager"),
+		},
+	} {
+		_, err := keeper.StoredGameAll(wctx, tc.request)
+		require.ErrorIs(t, err, tc.err)
+	}
+}
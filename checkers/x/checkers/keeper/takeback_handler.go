@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/rules"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Fetches the game whose pending takeback request the creator wants to accept.
+func (k Keeper) getTakebackRequestToAnswer(ctx sdk.Context, gameIndex string, creator string) (storedGame types.StoredGame, err error) {
+	storedGame, found := k.GetStoredGame(ctx, gameIndex)
+	if !found {
+		return storedGame, sdkerrors.Wrapf(types.ErrGameNotFound, "%s", gameIndex)
+	}
+	if storedGame.Winner != rules.PieceStrings[rules.NO_PLAYER] {
+		return storedGame, types.ErrGameFinished
+	}
+	if err := checkOfferToAnswer(storedGame, creator, storedGame.TakebackRequest, types.ErrNoTakebackRequest, types.ErrCannotAnswerOwnTakeback); err != nil {
+		return storedGame, err
+	}
+	return storedGame, nil
+}
+
+// Fetches the move log of a game, which has to hold all its hops for any of them to be taken back.
+func (k Keeper) getGameMovesToTakeBack(ctx sdk.Context, storedGame types.StoredGame) (gameMoves []types.GameMove, err error) {
+	gameMoves = k.GetAllGameMove(ctx, storedGame.Index)
+	if uint64(len(gameMoves)) != storedGame.MoveCount {
+		return nil, sdkerrors.Wrapf(types.ErrMoveLogIncomplete, "%s", storedGame.Index)
+	}
+	return gameMoves, nil
+}
+
+// countPlies tells how many plies the hops of the move log make, a new one starting each time the
+// player changes.
+func countPlies(gameMoves []types.GameMove) (plies uint64) {
+	for i, gameMove := range gameMoves {
+		if i == 0 || gameMoves[i-1].Player != gameMove.Player {
+			plies++
+		}
+	}
+	return plies
+}
+
+// Replays the game from its start without its last plies, and saves it as it was then. The move
+// log loses the hops taken back. What was spent on the clocks is not given back.
+func (k Keeper) mustTakeBack(ctx sdk.Context, storedGame *types.StoredGame, gameMoves []types.GameMove, plies uint64) {
+	keptPlies := countPlies(gameMoves) - plies
+	game, err := storedGame.ParseStartGame()
+	if err != nil {
+		panic(err.Error())
+	}
+	var kept uint64
+	for i, gameMove := range gameMoves {
+		if i == 0 || gameMoves[i-1].Player != gameMove.Player {
+			if keptPlies == 0 {
+				break
+			}
+			keptPlies--
+		}
+		_, err := game.Move(
+			rules.Pos{X: int(gameMove.FromX), Y: int(gameMove.FromY)},
+			rules.Pos{X: int(gameMove.ToX), Y: int(gameMove.ToY)},
+		)
+		if err != nil {
+			panic(err.Error())
+		}
+		kept++
+	}
+	k.RemoveGameMovesFrom(ctx, storedGame.Index, kept)
+	storedGame.Board = game.String()
+	storedGame.Turn = rules.PieceStrings[game.Turn]
+	storedGame.CapturingSquare = types.CapturingSquare(game)
+	storedGame.CapturedSquares = types.CapturedSquares(game)
+	storedGame.MoveCount = kept
+	storedGame.PositionHistory = game.History
+	storedGame.MovesWithoutProgress = uint64(game.MovesWithoutProgress)
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SetTournament set a specific tournament in the store from its index
+func (k Keeper) SetTournament(ctx sdk.Context, tournament types.Tournament) {
+	key, ok := types.TournamentKey(tournament.Index)
+	if !ok {
+		panic("Tournament index is not a number " + tournament.Index)
+	}
+	// The start time index follows the tournament for as long as it is open.
+	if previous, found := k.GetTournament(ctx, tournament.Index); found {
+		k.removeFromStartIndex(ctx, previous)
+	}
+	k.addToStartIndex(ctx, tournament)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TournamentKeyPrefix))
+	b := k.cdc.MustMarshal(&tournament)
+	store.Set(key, b)
+}
+
+// GetTournament returns a tournament from its index
+func (k Keeper) GetTournament(
+	ctx sdk.Context,
+	index string,
+
+) (val types.Tournament, found bool) {
+	key, ok := types.TournamentKey(index)
+	if !ok {
+		return val, false
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TournamentKeyPrefix))
+
+	b := store.Get(key)
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllTournament returns all tournament, oldest first
+func (k Keeper) GetAllTournament(ctx sdk.Context) (list []types.Tournament) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TournamentKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Tournament
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// Only open tournaments are waiting to start, so they are the only ones in the start time index.
+func (k Keeper) addToStartIndex(ctx sdk.Context, tournament types.Tournament) {
+	if tournament.Status != types.TournamentStatusOpen {
+		return
+	}
+	startTime, err := tournament.GetStartTimeAsTime()
+	if err != nil {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TournamentByStartKeyPrefix))
+	store.Set(types.TournamentByStartKey(startTime, tournament.Index), []byte(tournament.Index))
+}
+
+func (k Keeper) removeFromStartIndex(ctx sdk.Context, tournament types.Tournament) {
+	startTime, err := tournament.GetStartTimeAsTime()
+	if err != nil {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TournamentByStartKeyPrefix))
+	store.Delete(types.TournamentByStartKey(startTime, tournament.Index))
+}
+
+// GetTournamentIndicesToStart returns the indices of the open tournaments past their start time,
+// soonest first.
+func (k Keeper) GetTournamentIndicesToStart(ctx sdk.Context) (indices []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TournamentByStartKeyPrefix))
+	// As with deadlines, tournaments starting at the block time itself wait for the next block.
+	iterator := store.Iterator(nil, sdk.FormatTimeBytes(ctx.BlockTime()))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		indices = append(indices, string(iterator.Value()))
+	}
+	return
+}
@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CollectEntryFee escrows the entry fee of a player joining the tournament, into the prize pool.
+func (k *Keeper) CollectEntryFee(ctx sdk.Context, tournament *types.Tournament, player string) error {
+	playerAddress, err := sdk.AccAddressFromBech32(player)
+	if err != nil {
+		return sdkerrors.Wrapf(err, types.ErrCannotPayEntryFee.Error())
+	}
+	err = k.bank.SendCoinsFromAccountToModule(ctx, playerAddress, types.ModuleName, sdk.NewCoins(tournament.GetEntryFeeCoin()))
+	if err != nil {
+		return sdkerrors.Wrapf(err, types.ErrCannotPayEntryFee.Error())
+	}
+	return nil
+}
+
+// RefundEntryFees gives their entry fee back to the players of a cancelled tournament.
+func (k *Keeper) RefundEntryFees(ctx sdk.Context, tournament *types.Tournament) error {
+	for _, player := range tournament.Players {
+		playerAddress, err := sdk.AccAddressFromBech32(player)
+		if err != nil {
+			return sdkerrors.Wrapf(err, types.ErrCannotRefundWager.Error(), player)
+		}
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, playerAddress, sdk.NewCoins(tournament.GetEntryFeeCoin()))
+		if err != nil {
+			return sdkerrors.Wrapf(err, types.ErrCannotRefundWager.Error(), player)
+		}
+	}
+	return nil
+}
+
+// PayPrizes splits the prize pool equally between the winners, the top seed among them taking
+// what cannot be split.
+func (k *Keeper) PayPrizes(ctx sdk.Context, tournament *types.Tournament) (prizes []sdk.Coin, err error) {
+	pool := tournament.GetPrizePool()
+	share := pool.Amount.QuoRaw(int64(len(tournament.Winners)))
+	remainder := pool.Amount.Sub(share.MulRaw(int64(len(tournament.Winners))))
+	for i, winner := range tournament.Winners {
+		prize := sdk.NewCoin(pool.Denom, share)
+		if i == 0 {
+			prize = prize.AddAmount(remainder)
+		}
+		prizes = append(prizes, prize)
+		winnerAddress, err := sdk.AccAddressFromBech32(winner)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, types.ErrCannotPayPrize.Error(), winner)
+		}
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, winnerAddress, sdk.NewCoins(prize))
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, types.ErrCannotPayPrize.Error(), winner)
+		}
+	}
+	return prizes, nil
+}
+
+// RegisterTournamentResult records the result of a tournament game that just ended, and pairs the
+// next round once it was the last game of its round. The caller is left to save the system info,
+// which the new games change.
+func (k *Keeper) RegisterTournamentResult(ctx sdk.Context, storedGame *types.StoredGame, systemInfo *types.SystemInfo) error {
+	if storedGame.TournamentIndex == "" {
+		return nil
+	}
+	tournament, found := k.GetTournament(ctx, storedGame.TournamentIndex)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrTournamentNotFound, "%s", storedGame.TournamentIndex)
+	}
+	for i, game := range tournament.Games {
+		if game.GameIndex == storedGame.Index {
+			tournament.Games[i].Winner = storedGame.Winner
+		}
+	}
+	if tournament.IsRoundOver() {
+		if err := k.startNextRound(ctx, &tournament, systemInfo); err != nil {
+			return err
+		}
+	}
+	k.SetTournament(ctx, tournament)
+	return nil
+}
+
+// Creates the games of the next round, each one taking the next game index, or else finishes the
+// tournament and pays its winners. The system info is left untouched on error.
+func (k *Keeper) startNextRound(ctx sdk.Context, tournament *types.Tournament, systemInfo *types.SystemInfo) error {
+	games, done := tournament.NextRound()
+	if done {
+		tournament.Status = types.TournamentStatusFinished
+		tournament.Winners = tournament.Champions()
+		prizes, err := k.PayPrizes(ctx, tournament)
+		if err != nil {
+			return err
+		}
+		for i, winner := range tournament.Winners {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(types.TournamentFinishedEventType,
+					sdk.NewAttribute(types.TournamentFinishedEventTournamentIndex, tournament.Index),
+					sdk.NewAttribute(types.TournamentFinishedEventWinner, winner),
+					sdk.NewAttribute(types.TournamentFinishedEventPrize, prizes[i].String()),
+				),
+			)
+		}
+		return nil
+	}
+	tournament.Round++
+	nextId := systemInfo.NextId
+	for i, game := range games {
+		if game.Red == "" {
+			continue
+		}
+		newIndex := strconv.FormatUint(nextId, 10)
+		if err := k.createTournamentGame(ctx, newIndex, tournament.Index, game.Black, game.Red); err != nil {
+			return err
+		}
+		nextId++
+		games[i].GameIndex = newIndex
+	}
+	systemInfo.NextId = nextId
+	tournament.Games = append(tournament.Games, games...)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.TournamentRoundStartedEventType,
+			sdk.NewAttribute(types.TournamentRoundStartedEventTournamentIndex, tournament.Index),
+			sdk.NewAttribute(types.TournamentRoundStartedEventRound, strconv.FormatUint(tournament.Round, 10)),
+		),
+	)
+	return nil
+}
+
+// Runs a step of EndBlock, where an error cannot fail a transaction, on a branch of the store. Its
+// changes and events are kept only if it succeeds, else the error is logged, so that a step that
+// fails neither halts the chain nor leaves half of its changes behind.
+func (k Keeper) runOrLog(ctx sdk.Context, step string, run func(ctx sdk.Context) error) {
+	cacheCtx, write := ctx.CacheContext()
+	if err := run(cacheCtx); err != nil {
+		k.Logger(ctx).Error("EndBlock step failed", "step", step, "error", err.Error())
+		return
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
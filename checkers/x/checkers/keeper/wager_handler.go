@@ -3,15 +3,15 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/alice/checkers/rules"
 	"github.com/alice/checkers/x/checkers/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-func (k *Keeper) CollectWager(ctx sdk.Context, storedGame *types.StoredGame) error {
-
-	if storedGame.MoveCount == 0 {
-		// Black plays first
+// CollectWager escrows the stake of the player seated at the given color.
+func (k *Keeper) CollectWager(ctx sdk.Context, storedGame *types.StoredGame, color string) error {
+	if color == rules.PieceStrings[rules.BLACK_PLAYER] {
 		black, err := storedGame.GetBlackAddress()
 		if err != nil {
 			panic(err.Error())
@@ -20,8 +20,7 @@ func (k *Keeper) CollectWager(ctx sdk.Context, storedGame *types.StoredGame) err
 		if err != nil {
 			return sdkerrors.Wrapf(err, types.ErrBlackCannotPay.Error())
 		}
-	} else if storedGame.MoveCount == 1 {
-		// Red plays second
+	} else {
 		red, err := storedGame.GetRedAddress()
 		if err != nil {
 			panic(err.Error())
@@ -34,6 +33,7 @@ func (k *Keeper) CollectWager(ctx sdk.Context, storedGame *types.StoredGame) err
 	return nil
 }
 
+// Only active games have both stakes in escrow, so the winner takes twice the wager.
 func (k *Keeper) MustPayWinnings(ctx sdk.Context, storedGame *types.StoredGame) {
 	winnerAddress, found, err := storedGame.GetWinnerAddress()
 	if err != nil {
@@ -42,12 +42,11 @@ func (k *Keeper) MustPayWinnings(ctx sdk.Context, storedGame *types.StoredGame)
 	if !found {
 		panic(fmt.Sprintf(types.ErrCannotFindWinnerByColor.Error(), storedGame.Winner))
 	}
-	winnings := storedGame.GetWagerCoin()
-	if storedGame.MoveCount == 0 {
+	if storedGame.Status != types.GameStatusActive {
 		panic(types.ErrNothingToPay.Error())
-	} else if 1 < storedGame.MoveCount {
-		winnings = winnings.Add(winnings)
 	}
+	winnings := storedGame.GetWagerCoin()
+	winnings = winnings.Add(winnings)
 	err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, winnerAddress, sdk.NewCoins(winnings))
 	if err != nil {
 		panic(fmt.Sprintf(types.ErrCannotPayWinnings.Error(), err.Error()))
@@ -55,20 +54,62 @@ func (k *Keeper) MustPayWinnings(ctx sdk.Context, storedGame *types.StoredGame)
 }
 
 func (k *Keeper) MustRefundWager(ctx sdk.Context, storedGame *types.StoredGame) {
-	if storedGame.MoveCount == 1 {
-		// Refund
+	if storedGame.Status == types.GameStatusPending {
+		// Only the creator has escrowed their stake so far.
+		creator, err := sdk.AccAddressFromBech32(storedGame.Creator)
+		if err != nil {
+			panic(err.Error())
+		}
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, sdk.NewCoins(storedGame.GetWagerCoin()))
+		if err != nil {
+			panic(fmt.Sprintf(types.ErrCannotRefundWager.Error(), err.Error()))
+		}
+	} else if storedGame.Status == types.GameStatusActive {
+		// Both players have paid, so the pot is split back between them.
 		black, err := storedGame.GetBlackAddress()
 		if err != nil {
 			panic(err.Error())
 		}
+		red, err := storedGame.GetRedAddress()
+		if err != nil {
+			panic(err.Error())
+		}
 		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, black, sdk.NewCoins(storedGame.GetWagerCoin()))
 		if err != nil {
 			panic(fmt.Sprintf(types.ErrCannotRefundWager.Error(), err.Error()))
 		}
-	} else if storedGame.MoveCount == 0 {
-		// do nothing
+		err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, red, sdk.NewCoins(storedGame.GetWagerCoin()))
+		if err != nil {
+			panic(fmt.Sprintf(types.ErrCannotRefundWager.Error(), err.Error()))
+		}
 	} else {
-		// TODO Implement a draw mechanism.
 		panic(fmt.Sprintf(types.ErrNotInRefundState.Error(), storedGame.MoveCount))
 	}
 }
+
+// CollectChallengeWager escrows the stake of the challenge creator until someone accepts it.
+func (k *Keeper) CollectChallengeWager(ctx sdk.Context, challenge *types.Challenge) error {
+	creator, err := sdk.AccAddressFromBech32(challenge.Creator)
+	if err != nil {
+		panic(err.Error())
+	}
+	err = k.bank.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, sdk.NewCoins(challenge.GetWagerCoin()))
+	if err != nil {
+		if challenge.Color == rules.PieceStrings[rules.BLACK_PLAYER] {
+			return sdkerrors.Wrapf(err, types.ErrBlackCannotPay.Error())
+		}
+		return sdkerrors.Wrapf(err, types.ErrRedCannotPay.Error())
+	}
+	return nil
+}
+
+func (k *Keeper) MustRefundChallengeWager(ctx sdk.Context, challenge *types.Challenge) {
+	creator, err := sdk.AccAddressFromBech32(challenge.Creator)
+	if err != nil {
+		panic(err.Error())
+	}
+	err = k.bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creator, sdk.NewCoins(challenge.GetWagerCoin()))
+	if err != nil {
+		panic(fmt.Sprintf(types.ErrCannotRefundWager.Error(), err.Error()))
+	}
+}
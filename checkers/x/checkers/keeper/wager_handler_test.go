@@ -37,7 +37,7 @@ func TestWagerHandlerCollectWrongNoBlack(t *testing.T) {
 	}()
 	keeper.CollectWager(ctx, &types.StoredGame{
 		MoveCount: 0,
-	})
+	}, "b")
 }
 
 // Or when the black player failed to escrow the wager:
@@ -53,7 +53,7 @@ func TestWagerHandlerCollectFailedNoMove(t *testing.T) {
 		Black:     alice,
 		MoveCount: 0,
 		Wager:     45,
-	})
+	}, "b")
 	require.NotNil(t, err)
 	require.EqualError(t, err, "black cannot pay the wager: Oops")
 }
@@ -91,7 +91,23 @@ func TestWagerHandlerCollectNoMove(t *testing.T) {
 		Black:     alice,
 		MoveCount: 0,
 		Wager:     45,
-	})
+	}, "b")
+	require.Nil(t, err)
+}
+
+func TestWagerHandlerCollectInDenom(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	black, _ := sdk.AccAddressFromBech32(alice)
+	escrow.EXPECT().
+		SendCoinsFromAccountToModule(ctx, black, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("ibc/ATOM", 45)))
+	err := keeper.CollectWager(ctx, &types.StoredGame{
+		Black:     alice,
+		MoveCount: 0,
+		Wager:     45,
+		Denom:     "ibc/ATOM",
+	}, "b")
 	require.Nil(t, err)
 }
 
@@ -117,6 +133,7 @@ func TestWagerHandlerPayWrongEscrowFailed(t *testing.T) {
 		Winner:    "b",
 		MoveCount: 1,
 		Wager:     45,
+		Status:    types.GameStatusActive,
 	})
 }
 
@@ -133,31 +150,57 @@ func TestWagerHandlerPayEscrowCalledTwoMoves(t *testing.T) {
 		Winner:    "b",
 		MoveCount: 2,
 		Wager:     45,
+		Status:    types.GameStatusActive,
+	})
+}
+
+func TestWagerHandlerPayInDenom(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	red, _ := sdk.AccAddressFromBech32(bob)
+	escrow.EXPECT().
+		SendCoinsFromModuleToAccount(ctx, types.ModuleName, red, sdk.NewCoins(sdk.NewInt64Coin("ibc/ATOM", 90)))
+	keeper.MustPayWinnings(ctx, &types.StoredGame{
+		Black:     alice,
+		Red:       bob,
+		Winner:    "r",
+		MoveCount: 2,
+		Wager:     45,
+		Denom:     "ibc/ATOM",
+		Status:    types.GameStatusActive,
 	})
 }
 
 // refunds
 
-func TestWagerHandlerRefundWrongManyMoves(t *testing.T) {
-	keeper, context, ctrl, _ := setupKeeperForWagerHandler(t)
+func TestWagerHandlerRefundDrawCalled(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
-	defer func() {
-		r := recover()
-		require.NotNil(t, r, "The code did not panic")
-		require.Equal(t, "game is not in a state to refund, move count: 2", r)
-	}()
+	escrow.ExpectRefund(context, alice, 45)
+	escrow.ExpectRefund(context, bob, 45)
 	keeper.MustRefundWager(ctx, &types.StoredGame{
+		Black:     alice,
+		Red:       bob,
 		MoveCount: 2,
+		Wager:     45,
+		Status:    types.GameStatusActive,
 	})
 }
 
-func TestWagerHandlerRefundNoMoves(t *testing.T) {
+func TestWagerHandlerRefundFinished(t *testing.T) {
 	keeper, context, ctrl, _ := setupKeeperForWagerHandler(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
+	defer func() {
+		r := recover()
+		require.NotNil(t, r, "The code did not panic")
+		require.Equal(t, "game is not in a state to refund, move count: 20", r)
+	}()
 	keeper.MustRefundWager(ctx, &types.StoredGame{
-		MoveCount: 0,
+		MoveCount: 20,
+		Status:    types.GameStatusFinished,
 	})
 }
 
@@ -172,6 +215,7 @@ func TestWagerHandlerRefundWrongNoBlack(t *testing.T) {
 	}()
 	keeper.MustRefundWager(ctx, &types.StoredGame{
 		MoveCount: 1,
+		Status:    types.GameStatusActive,
 	})
 }
 
@@ -191,19 +235,56 @@ func TestWagerHandlerRefundWrongEscrowFailed(t *testing.T) {
 	}()
 	keeper.MustRefundWager(ctx, &types.StoredGame{
 		Black:     alice,
-		MoveCount: 1,
+		Creator:   alice,
+		MoveCount: 0,
 		Wager:     45,
+		Status:    types.GameStatusPending,
 	})
 }
 
-func TestWagerHandlerRefundCalled(t *testing.T) {
+// A pending game only has the creator's stake in escrow.
+func TestWagerHandlerRefundPendingCalled(t *testing.T) {
 	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
 	ctx := sdk.UnwrapSDKContext(context)
 	defer ctrl.Finish()
-	escrow.ExpectRefund(context, alice, 45)
+	escrow.ExpectRefund(context, bob, 45)
 	keeper.MustRefundWager(ctx, &types.StoredGame{
 		Black:     alice,
-		MoveCount: 1,
+		Red:       bob,
+		Creator:   bob,
+		MoveCount: 0,
 		Wager:     45,
+		Status:    types.GameStatusPending,
 	})
 }
+
+func TestWagerHandlerPayPending(t *testing.T) {
+	keeper, context, ctrl, _ := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	defer func() {
+		r := recover()
+		require.NotNil(t, r, "The code did not panic")
+		require.Equal(t, "there is nothing to pay, should not have been called", r)
+	}()
+	keeper.MustPayWinnings(ctx, &types.StoredGame{
+		Black:  alice,
+		Red:    bob,
+		Winner: "b",
+		Wager:  45,
+		Status: types.GameStatusPending,
+	})
+}
+
+func TestWagerHandlerCollectRed(t *testing.T) {
+	keeper, context, ctrl, escrow := setupKeeperForWagerHandler(t)
+	ctx := sdk.UnwrapSDKContext(context)
+	defer ctrl.Finish()
+	escrow.ExpectPay(context, bob, 45)
+	err := keeper.CollectWager(ctx, &types.StoredGame{
+		Black: alice,
+		Red:   bob,
+		Wager: 45,
+	}, "r")
+	require.Nil(t, err)
+}
@@ -171,7 +171,10 @@ func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 // EndBlock executes all ABCI EndBlock logic respective to the capability module. It
 // returns no validator updates.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
-	// calls the forfeit of the expired games using fifo.
+	// Forfeits the expired games, then refreshes the leaderboard with their results too.
 	am.keeper.ForfeitExpiredGames(sdk.WrapSDKContext(ctx))
+	am.keeper.ExpireChallenges(sdk.WrapSDKContext(ctx))
+	am.keeper.StartTournaments(sdk.WrapSDKContext(ctx))
+	am.keeper.RefreshLeaderboard(sdk.WrapSDKContext(ctx))
 	return []abci.ValidatorUpdate{}
 }
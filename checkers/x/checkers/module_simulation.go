@@ -36,6 +36,62 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgRejectGame int = 100
 
+	opWeightMsgOfferDraw = "op_weight_msg_offer_draw"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgOfferDraw int = 100
+
+	opWeightMsgAcceptDraw = "op_weight_msg_accept_draw"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAcceptDraw int = 100
+
+	opWeightMsgDeclineDraw = "op_weight_msg_decline_draw"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgDeclineDraw int = 100
+
+	opWeightMsgPlayMoves = "op_weight_msg_play_moves"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgPlayMoves int = 100
+
+	opWeightMsgCreateChallenge = "op_weight_msg_create_challenge"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCreateChallenge int = 100
+
+	opWeightMsgAcceptChallenge = "op_weight_msg_accept_challenge"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAcceptChallenge int = 100
+
+	opWeightMsgAcceptGame = "op_weight_msg_accept_game"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAcceptGame int = 100
+
+	opWeightMsgCreateTournament = "op_weight_msg_create_tournament"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCreateTournament int = 100
+
+	opWeightMsgJoinTournament = "op_weight_msg_join_tournament"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgJoinTournament int = 100
+
+	opWeightMsgResign = "op_weight_msg_resign"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgResign int = 100
+
+	opWeightMsgRequestTakeback = "op_weight_msg_request_takeback"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRequestTakeback int = 100
+
+	opWeightMsgAcceptTakeback = "op_weight_msg_accept_takeback"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAcceptTakeback int = 100
+
+	opWeightMsgOfferRematch = "op_weight_msg_offer_rematch"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgOfferRematch int = 100
+
+	opWeightMsgAcceptRematch = "op_weight_msg_accept_rematch"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAcceptRematch int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -103,6 +159,160 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		checkerssimulation.SimulateMsgRejectGame(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgOfferDraw int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgOfferDraw, &weightMsgOfferDraw, nil,
+		func(_ *rand.Rand) {
+			weightMsgOfferDraw = defaultWeightMsgOfferDraw
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgOfferDraw,
+		checkerssimulation.SimulateMsgOfferDraw(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAcceptDraw int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAcceptDraw, &weightMsgAcceptDraw, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptDraw = defaultWeightMsgAcceptDraw
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptDraw,
+		checkerssimulation.SimulateMsgAcceptDraw(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgDeclineDraw int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgDeclineDraw, &weightMsgDeclineDraw, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeclineDraw = defaultWeightMsgDeclineDraw
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDeclineDraw,
+		checkerssimulation.SimulateMsgDeclineDraw(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgPlayMoves int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgPlayMoves, &weightMsgPlayMoves, nil,
+		func(_ *rand.Rand) {
+			weightMsgPlayMoves = defaultWeightMsgPlayMoves
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgPlayMoves,
+		checkerssimulation.SimulateMsgPlayMoves(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgCreateChallenge int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgCreateChallenge, &weightMsgCreateChallenge, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateChallenge = defaultWeightMsgCreateChallenge
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateChallenge,
+		checkerssimulation.SimulateMsgCreateChallenge(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAcceptChallenge int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAcceptChallenge, &weightMsgAcceptChallenge, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptChallenge = defaultWeightMsgAcceptChallenge
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptChallenge,
+		checkerssimulation.SimulateMsgAcceptChallenge(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAcceptGame int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAcceptGame, &weightMsgAcceptGame, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptGame = defaultWeightMsgAcceptGame
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptGame,
+		checkerssimulation.SimulateMsgAcceptGame(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgCreateTournament int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgCreateTournament, &weightMsgCreateTournament, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateTournament = defaultWeightMsgCreateTournament
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateTournament,
+		checkerssimulation.SimulateMsgCreateTournament(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgJoinTournament int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgJoinTournament, &weightMsgJoinTournament, nil,
+		func(_ *rand.Rand) {
+			weightMsgJoinTournament = defaultWeightMsgJoinTournament
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgJoinTournament,
+		checkerssimulation.SimulateMsgJoinTournament(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgResign int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgResign, &weightMsgResign, nil,
+		func(_ *rand.Rand) {
+			weightMsgResign = defaultWeightMsgResign
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgResign,
+		checkerssimulation.SimulateMsgResign(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgRequestTakeback int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgRequestTakeback, &weightMsgRequestTakeback, nil,
+		func(_ *rand.Rand) {
+			weightMsgRequestTakeback = defaultWeightMsgRequestTakeback
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRequestTakeback,
+		checkerssimulation.SimulateMsgRequestTakeback(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAcceptTakeback int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAcceptTakeback, &weightMsgAcceptTakeback, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptTakeback = defaultWeightMsgAcceptTakeback
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptTakeback,
+		checkerssimulation.SimulateMsgAcceptTakeback(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgOfferRematch int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgOfferRematch, &weightMsgOfferRematch, nil,
+		func(_ *rand.Rand) {
+			weightMsgOfferRematch = defaultWeightMsgOfferRematch
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgOfferRematch,
+		checkerssimulation.SimulateMsgOfferRematch(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAcceptRematch int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsgAcceptRematch, &weightMsgAcceptRematch, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptRematch = defaultWeightMsgAcceptRematch
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptRematch,
+		checkerssimulation.SimulateMsgAcceptRematch(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -8,16 +8,35 @@ import (
 	"github.com/golang/mock/gomock"
 )
 
+// Matches the contexts of the same block, including the branches of its store on which the keeper
+// runs the steps of EndBlock.
+type blockContext struct {
+	ctx sdk.Context
+}
+
+func sameBlock(context context.Context) gomock.Matcher {
+	return blockContext{ctx: sdk.UnwrapSDKContext(context)}
+}
+
+func (m blockContext) Matches(x interface{}) bool {
+	other, ok := x.(sdk.Context)
+	return ok && other.BlockHeight() == m.ctx.BlockHeight() && other.BlockTime().Equal(m.ctx.BlockTime())
+}
+
+func (m blockContext) String() string {
+	return "is a context of the block at " + m.ctx.BlockTime().String()
+}
+
 func (escrow *MockBankEscrowKeeper) ExpectAny(context context.Context) {
-	escrow.EXPECT().SendCoinsFromAccountToModule(sdk.UnwrapSDKContext(context), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
-	escrow.EXPECT().SendCoinsFromModuleToAccount(sdk.UnwrapSDKContext(context), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	escrow.EXPECT().SendCoinsFromAccountToModule(sameBlock(context), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	escrow.EXPECT().SendCoinsFromModuleToAccount(sameBlock(context), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 }
 
 func coinsOf(amount uint64) sdk.Coins {
 	return sdk.Coins{
 		sdk.Coin{
 			Denom:  sdk.DefaultBondDenom,
-			Amount: sdk.NewInt(int64(amount)),
+			Amount: sdk.NewIntFromUint64(amount),
 		},
 	}
 }
@@ -27,7 +46,7 @@ func (escrow *MockBankEscrowKeeper) ExpectPay(context context.Context, who strin
 	if err != nil {
 		panic(err)
 	}
-	return escrow.EXPECT().SendCoinsFromAccountToModule(sdk.UnwrapSDKContext(context), whoAddr, types.ModuleName, coinsOf(amount))
+	return escrow.EXPECT().SendCoinsFromAccountToModule(sameBlock(context), whoAddr, types.ModuleName, coinsOf(amount))
 }
 
 func (escrow *MockBankEscrowKeeper) ExpectRefund(context context.Context, who string, amount uint64) *gomock.Call {
@@ -35,5 +54,5 @@ func (escrow *MockBankEscrowKeeper) ExpectRefund(context context.Context, who st
 	if err != nil {
 		panic(err)
 	}
-	return escrow.EXPECT().SendCoinsFromModuleToAccount(sdk.UnwrapSDKContext(context), types.ModuleName, whoAddr, coinsOf(amount))
+	return escrow.EXPECT().SendCoinsFromModuleToAccount(sameBlock(context), types.ModuleName, whoAddr, coinsOf(amount))
 }
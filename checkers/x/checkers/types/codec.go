@@ -11,6 +11,20 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateGame{}, "checkers/CreateGame", nil)
 	cdc.RegisterConcrete(&MsgPlayMove{}, "checkers/PlayMove", nil)
 	cdc.RegisterConcrete(&MsgRejectGame{}, "checkers/RejectGame", nil)
+	cdc.RegisterConcrete(&MsgOfferDraw{}, "checkers/OfferDraw", nil)
+	cdc.RegisterConcrete(&MsgAcceptDraw{}, "checkers/AcceptDraw", nil)
+	cdc.RegisterConcrete(&MsgDeclineDraw{}, "checkers/DeclineDraw", nil)
+	cdc.RegisterConcrete(&MsgPlayMoves{}, "checkers/PlayMoves", nil)
+	cdc.RegisterConcrete(&MsgCreateChallenge{}, "checkers/CreateChallenge", nil)
+	cdc.RegisterConcrete(&MsgAcceptChallenge{}, "checkers/AcceptChallenge", nil)
+	cdc.RegisterConcrete(&MsgAcceptGame{}, "checkers/AcceptGame", nil)
+	cdc.RegisterConcrete(&MsgCreateTournament{}, "checkers/CreateTournament", nil)
+	cdc.RegisterConcrete(&MsgJoinTournament{}, "checkers/JoinTournament", nil)
+	cdc.RegisterConcrete(&MsgResign{}, "checkers/Resign", nil)
+	cdc.RegisterConcrete(&MsgRequestTakeback{}, "checkers/RequestTakeback", nil)
+	cdc.RegisterConcrete(&MsgAcceptTakeback{}, "checkers/AcceptTakeback", nil)
+	cdc.RegisterConcrete(&MsgOfferRematch{}, "checkers/OfferRematch", nil)
+	cdc.RegisterConcrete(&MsgAcceptRematch{}, "checkers/AcceptRematch", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -24,6 +38,48 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgRejectGame{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgOfferDraw{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAcceptDraw{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDeclineDraw{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPlayMoves{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateChallenge{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAcceptChallenge{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAcceptGame{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateTournament{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgJoinTournament{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgResign{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRequestTakeback{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAcceptTakeback{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgOfferRematch{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAcceptRematch{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -12,22 +12,65 @@ import (
 // )
 
 var (
-	ErrInvalidBlack            = sdkerrors.Register(ModuleName, 1100, "black address is invalid: %s")
-	ErrInvalidRed              = sdkerrors.Register(ModuleName, 1101, "red address is invalid: %s")
-	ErrGameNotParsable         = sdkerrors.Register(ModuleName, 1102, "game cannot be parsed")
-	ErrGameNotFound            = sdkerrors.Register(ModuleName, 1103, "game by id not found")
-	ErrCreatorNotPlayer        = sdkerrors.Register(ModuleName, 1104, "message creator is not a player")
-	ErrNotPlayerTurn           = sdkerrors.Register(ModuleName, 1105, "player tried to play out of turn")
-	ErrWrongMove               = sdkerrors.Register(ModuleName, 1106, "wrong move")
-	ErrBlackAlreadyPlayed      = sdkerrors.Register(ModuleName, 1107, "black player has already played")
-	ErrRedAlreadyPlayed        = sdkerrors.Register(ModuleName, 1108, "red player has already played")
-	ErrInvalidDeadline         = sdkerrors.Register(ModuleName, 1109, "deadline cannot be parsed: %s")
-	ErrGameFinished            = sdkerrors.Register(ModuleName, 1110, "game is already finished")
-	ErrCannotFindWinnerByColor = sdkerrors.Register(ModuleName, 1111, "cannot find winner by color: %s")
-	ErrBlackCannotPay          = sdkerrors.Register(ModuleName, 1112, "black cannot pay the wager")
-	ErrRedCannotPay            = sdkerrors.Register(ModuleName, 1113, "red cannot pay the wager")
-	ErrNothingToPay            = sdkerrors.Register(ModuleName, 1114, "there is nothing to pay, should not have been called")
-	ErrCannotRefundWager       = sdkerrors.Register(ModuleName, 1115, "cannot refund wager to: %s")
-	ErrCannotPayWinnings       = sdkerrors.Register(ModuleName, 1116, "cannot pay winnings to winner: %s")
-	ErrNotInRefundState        = sdkerrors.Register(ModuleName, 1117, "game is not in a state to refund, move count: %d")
+	ErrInvalidBlack              = sdkerrors.Register(ModuleName, 1100, "black address is invalid: %s")
+	ErrInvalidRed                = sdkerrors.Register(ModuleName, 1101, "red address is invalid: %s")
+	ErrGameNotParsable           = sdkerrors.Register(ModuleName, 1102, "game cannot be parsed")
+	ErrGameNotFound              = sdkerrors.Register(ModuleName, 1103, "game by id not found")
+	ErrCreatorNotPlayer          = sdkerrors.Register(ModuleName, 1104, "message creator is not a player")
+	ErrNotPlayerTurn             = sdkerrors.Register(ModuleName, 1105, "player tried to play out of turn")
+	ErrWrongMove                 = sdkerrors.Register(ModuleName, 1106, "wrong move")
+	ErrBlackAlreadyPlayed        = sdkerrors.Register(ModuleName, 1107, "black player has already played")
+	ErrRedAlreadyPlayed          = sdkerrors.Register(ModuleName, 1108, "red player has already played")
+	ErrInvalidDeadline           = sdkerrors.Register(ModuleName, 1109, "deadline cannot be parsed: %s")
+	ErrGameFinished              = sdkerrors.Register(ModuleName, 1110, "game is already finished")
+	ErrCannotFindWinnerByColor   = sdkerrors.Register(ModuleName, 1111, "cannot find winner by color: %s")
+	ErrBlackCannotPay            = sdkerrors.Register(ModuleName, 1112, "black cannot pay the wager")
+	ErrRedCannotPay              = sdkerrors.Register(ModuleName, 1113, "red cannot pay the wager")
+	ErrNothingToPay              = sdkerrors.Register(ModuleName, 1114, "there is nothing to pay, should not have been called")
+	ErrCannotRefundWager         = sdkerrors.Register(ModuleName, 1115, "cannot refund wager to: %s")
+	ErrCannotPayWinnings         = sdkerrors.Register(ModuleName, 1116, "cannot pay winnings to winner: %s")
+	ErrNotInRefundState          = sdkerrors.Register(ModuleName, 1117, "game is not in a state to refund, move count: %d")
+	ErrDrawAlreadyOffered        = sdkerrors.Register(ModuleName, 1118, "a draw offer is already pending")
+	ErrNoDrawOffer               = sdkerrors.Register(ModuleName, 1119, "there is no draw offer to answer")
+	ErrCannotAnswerOwnDraw       = sdkerrors.Register(ModuleName, 1120, "player cannot answer their own draw offer")
+	ErrPathTooShort              = sdkerrors.Register(ModuleName, 1121, "path needs at least a start and an end position, got: %d")
+	ErrUnknownVariant            = sdkerrors.Register(ModuleName, 1122, "unknown rules variant: %s")
+	ErrInvalidFen                = sdkerrors.Register(ModuleName, 1123, "invalid starting position: %s")
+	ErrChallengeNotFound         = sdkerrors.Register(ModuleName, 1124, "challenge by id not found")
+	ErrInvalidColor              = sdkerrors.Register(ModuleName, 1125, "color must be b or r, got: %s")
+	ErrCannotAcceptOwnChallenge  = sdkerrors.Register(ModuleName, 1126, "player cannot accept their own challenge")
+	ErrDenomNotAllowed           = sdkerrors.Register(ModuleName, 1127, "denomination not allowed for wagers: %s")
+	ErrGameNotPending            = sdkerrors.Register(ModuleName, 1128, "game is not waiting to be accepted")
+	ErrGameNotAccepted           = sdkerrors.Register(ModuleName, 1129, "game has not been accepted yet")
+	ErrCannotAcceptOwnGame       = sdkerrors.Register(ModuleName, 1130, "player cannot accept their own game")
+	ErrInvalidTimeControl        = sdkerrors.Register(ModuleName, 1131, "invalid time control")
+	ErrTimeControlOutOfBounds    = sdkerrors.Register(ModuleName, 1132, "time control out of bounds")
+	ErrGameNotFinished           = sdkerrors.Register(ModuleName, 1133, "game is not finished, there is no result to register")
+	ErrInvalidDateAdded          = sdkerrors.Register(ModuleName, 1134, "date added cannot be parsed: %s")
+	ErrInvalidLeaderboardAddress = sdkerrors.Register(ModuleName, 1135, "leaderboard player address is invalid: %s")
+	ErrTournamentNotFound        = sdkerrors.Register(ModuleName, 1136, "tournament by id not found")
+	ErrUnknownTournamentFormat   = sdkerrors.Register(ModuleName, 1137, "unknown tournament format: %s")
+	ErrInvalidMaxPlayers         = sdkerrors.Register(ModuleName, 1138, "max players out of bounds: %d")
+	ErrInvalidStartTime          = sdkerrors.Register(ModuleName, 1139, "start time cannot be parsed: %s")
+	ErrStartTimePassed           = sdkerrors.Register(ModuleName, 1140, "start time has already passed: %s")
+	ErrTournamentNotOpen         = sdkerrors.Register(ModuleName, 1141, "tournament is not open to players")
+	ErrTournamentFull            = sdkerrors.Register(ModuleName, 1142, "tournament has no seat left")
+	ErrAlreadyJoined             = sdkerrors.Register(ModuleName, 1143, "player already joined the tournament")
+	ErrCannotPayEntryFee         = sdkerrors.Register(ModuleName, 1144, "player cannot pay the entry fee")
+	ErrCannotPayPrize            = sdkerrors.Register(ModuleName, 1145, "cannot pay the prize to: %s")
+	ErrTournamentGameRejected    = sdkerrors.Register(ModuleName, 1146, "tournament games cannot be rejected")
+	ErrInvalidPlies              = sdkerrors.Register(ModuleName, 1147, "plies to take back must be positive")
+	ErrNotEnoughPlies            = sdkerrors.Register(ModuleName, 1148, "cannot take back more plies than were played: %d")
+	ErrTakebackAlreadyRequested  = sdkerrors.Register(ModuleName, 1149, "a takeback is already requested by: %s")
+	ErrNoTakebacksLeft           = sdkerrors.Register(ModuleName, 1150, "no takebacks left in this game")
+	ErrNoTakebackRequest         = sdkerrors.Register(ModuleName, 1151, "there is no takeback request to answer")
+	ErrCannotAnswerOwnTakeback   = sdkerrors.Register(ModuleName, 1152, "player cannot answer their own takeback request")
+	ErrMoveLogIncomplete         = sdkerrors.Register(ModuleName, 1153, "the move log of the game is incomplete: %s")
+	ErrRematchGameNotFinished    = sdkerrors.Register(ModuleName, 1154, "only a finished game can be played again")
+	ErrRematchAlreadyOffered     = sdkerrors.Register(ModuleName, 1155, "a rematch is already offered by: %s")
+	ErrRematchAlreadyPlayed      = sdkerrors.Register(ModuleName, 1156, "the game already has a rematch: %s")
+	ErrNoRematchOffer            = sdkerrors.Register(ModuleName, 1157, "there is no rematch offer to answer")
+	ErrCannotAnswerOwnRematch    = sdkerrors.Register(ModuleName, 1158, "player cannot answer their own rematch offer")
+	ErrInconsistentTimeParams    = sdkerrors.Register(ModuleName, 1159, "time control params are inconsistent")
+	ErrChallengeExpired          = sdkerrors.Register(ModuleName, 1160, "challenge expired")
 )
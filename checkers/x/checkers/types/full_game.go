@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/alice/checkers/rules"
@@ -20,9 +21,21 @@ func (storedGame StoredGame) GetRedAddress() (red sdk.AccAddress, err error) {
 	return red, sdkerrors.Wrapf(errRed, ErrInvalidRed.Error(), storedGame.Red)
 }
 
+func (storedGame StoredGame) ParseVariant() (variant *rules.Variant, err error) {
+	variant, found := rules.Variants[storedGame.Variant]
+	if !found {
+		return nil, sdkerrors.Wrapf(ErrUnknownVariant, "%s", storedGame.Variant)
+	}
+	return variant, nil
+}
+
 func (storedGame StoredGame) ParseGame() (game *rules.Game, err error) {
+	variant, err := storedGame.ParseVariant()
+	if err != nil {
+		return nil, err
+	}
 	// Parse the board and see if the move is valid.
-	board, errBoard := rules.Parse(storedGame.Board)
+	board, errBoard := variant.Parse(storedGame.Board)
 	if errBoard != nil {
 		return nil, sdkerrors.Wrapf(errBoard, ErrGameNotParsable.Error())
 	}
@@ -32,10 +45,65 @@ func (storedGame StoredGame) ParseGame() (game *rules.Game, err error) {
 	if board.Turn.Color == "" {
 		return nil, sdkerrors.Wrapf(errors.New(fmt.Sprintf("Turn: %s", storedGame.Turn)), ErrGameNotParsable.Error())
 	}
+	// Restore what the rules need to detect a draw
+	board.History = storedGame.PositionHistory
+	board.MovesWithoutProgress = int(storedGame.MovesWithoutProgress)
+	// Restore the piece that is in the middle of a multi-jump, if any
+	if storedGame.CapturingSquare != 0 {
+		capturing, errSquare := variant.SquarePos(int(storedGame.CapturingSquare))
+		if errSquare != nil {
+			return nil, sdkerrors.Wrapf(errSquare, ErrGameNotParsable.Error())
+		}
+		board.Capturing = &capturing
+	}
+	for _, square := range storedGame.CapturedSquares {
+		captured, errSquare := variant.SquarePos(int(square))
+		if errSquare != nil {
+			return nil, sdkerrors.Wrapf(errSquare, ErrGameNotParsable.Error())
+		}
+		board.Captured = append(board.Captured, captured)
+	}
 	// return the board with the new move
 	return board, nil
 }
 
+// CapturingSquare returns the square to store for the piece in the middle of a multi-jump, or 0.
+func CapturingSquare(game *rules.Game) uint64 {
+	if game.Capturing == nil {
+		return 0
+	}
+	return uint64(game.Variant.Square(*game.Capturing))
+}
+
+// CapturedSquares returns the squares to store for the pieces captured so far in the multi-jump.
+func CapturedSquares(game *rules.Game) []uint64 {
+	if len(game.Captured) == 0 {
+		return nil
+	}
+	squares := make([]uint64, 0, len(game.Captured))
+	for _, captured := range game.Captured {
+		squares = append(squares, uint64(game.Variant.Square(captured)))
+	}
+	return squares
+}
+
+// ParseStartGame returns the game as it was before its first move, from the position it was set up
+// from, if any.
+func (storedGame StoredGame) ParseStartGame() (game *rules.Game, err error) {
+	variant, err := storedGame.ParseVariant()
+	if err != nil {
+		return nil, err
+	}
+	if storedGame.Fen == "" {
+		return variant.New(), nil
+	}
+	game, err = variant.ParseFen(storedGame.Fen)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidFen, "%s", err.Error())
+	}
+	return game, nil
+}
+
 // Add a function that checks the games validity:
 
 func (storedGame StoredGame) Validate() (err error) {
@@ -69,8 +137,40 @@ func FormatDeadline(deadline time.Time) string {
 	return deadline.UTC().Format(DeadlineLayout)
 }
 
-func GetNextDeadline(ctx sdk.Context) time.Time {
-	return ctx.BlockTime().Add(MaxTurnDuration)
+// GetNextDeadline gives the player whose turn it is the game's turn duration, or what is left on their clock.
+func (storedGame StoredGame) GetNextDeadline(ctx sdk.Context) time.Time {
+	if !storedGame.TimeControl.HasClock() {
+		return ctx.BlockTime().Add(storedGame.TimeControl.TurnDuration)
+	}
+	if storedGame.Turn == rules.PieceStrings[rules.BLACK_PLAYER] {
+		return ctx.BlockTime().Add(storedGame.BlackClock)
+	}
+	return ctx.BlockTime().Add(storedGame.RedClock)
+}
+
+// StopClock takes the time spent since the turn started off the clock of the player whose turn it
+// is, and adds the increment when their turn is over. Games without a clock are left as they are.
+func (storedGame *StoredGame) StopClock(ctx sdk.Context, turnOver bool) error {
+	if !storedGame.TimeControl.HasClock() {
+		return nil
+	}
+	deadline, err := storedGame.GetDeadlineAsTime()
+	if err != nil {
+		return err
+	}
+	left := deadline.Sub(ctx.BlockTime())
+	if left < 0 {
+		left = 0
+	}
+	if turnOver {
+		left += storedGame.TimeControl.Increment
+	}
+	if storedGame.Turn == rules.PieceStrings[rules.BLACK_PLAYER] {
+		storedGame.BlackClock = left
+	} else {
+		storedGame.RedClock = left
+	}
+	return nil
 }
 
 func (storedGame StoredGame) GetPlayerAddress(color string) (address sdk.AccAddress, found bool, err error) {
@@ -94,5 +194,44 @@ func (storedGame StoredGame) GetWinnerAddress() (address sdk.AccAddress, found b
 }
 
 func (storedGame *StoredGame) GetWagerCoin() (wager sdk.Coin) {
-	return sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(int64(storedGame.Wager)))
+	return sdk.NewCoin(WagerDenom(storedGame.Denom), sdk.NewIntFromUint64(storedGame.Wager))
+}
+
+// WagerDenom returns the denomination a wager is paid in, the staking one when none is given.
+func WagerDenom(denom string) string {
+	if denom == "" {
+		return sdk.DefaultBondDenom
+	}
+	return denom
+}
+
+// ValidateWager caps wagers and entry fees at the largest int64, which is as far as the amounts
+// of the bank and of most clients go without overflowing.
+func ValidateWager(wager uint64) error {
+	if math.MaxInt64 < wager {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount too large: %d", wager)
+	}
+	return nil
+}
+
+// ParseStartFen reads the position a game starts from, which has to leave the side to
+// move with something to play.
+func ParseStartFen(variant *rules.Variant, fen string) (game *rules.Game, err error) {
+	game, err = variant.ParseFen(fen)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidFen, "%s", err.Error())
+	}
+	if game.Status() != rules.NO_PLAYER {
+		return nil, sdkerrors.Wrapf(ErrInvalidFen, "%s has no move left", game.Turn.Color)
+	}
+	return game, nil
+}
+
+func (challenge Challenge) GetWagerCoin() (wager sdk.Coin) {
+	return sdk.NewCoin(WagerDenom(challenge.Denom), sdk.NewIntFromUint64(challenge.Wager))
+}
+
+func (challenge Challenge) GetDeadlineAsTime() (deadline time.Time, err error) {
+	deadline, errDeadline := time.Parse(DeadlineLayout, challenge.Deadline)
+	return deadline, sdkerrors.Wrapf(errDeadline, ErrInvalidDeadline.Error(), challenge.Deadline)
 }
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"math"
 	"testing"
 	"time"
 
@@ -18,19 +19,25 @@ const (
 
 func GetStoredGame1() types.StoredGame {
 	return types.StoredGame{
-		Black:       alice,
-		Red:         bob,
-		Index:       "1",
-		Board:       rules.New().String(),
-		Turn:        "b",
-		MoveCount:   0,
-		BeforeIndex: types.NoFifoIndex,
-		AfterIndex:  types.NoFifoIndex,
-		Deadline:    types.DeadlineLayout,
-		Winner:      rules.PieceStrings[rules.NO_PLAYER],
+		Black:     alice,
+		Red:       bob,
+		Index:     "1",
+		Board:     rules.New().String(),
+		Turn:      "b",
+		MoveCount: 0,
+		Deadline:  types.DeadlineLayout,
+		Winner:    rules.PieceStrings[rules.NO_PLAYER],
 	}
 }
 
+func TestGetWagerCoinDoesNotWrap(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.Wager = math.MaxUint64
+	require.Equal(t, "18446744073709551615stake", storedGame.GetWagerCoin().String())
+	challenge := types.Challenge{Wager: math.MaxUint64, Denom: "coin"}
+	require.Equal(t, "18446744073709551615coin", challenge.GetWagerCoin().String())
+}
+
 func TestCanGetAddressBlack(t *testing.T) {
 	aliceAddress, err1 := sdk.AccAddressFromBech32(alice)
 	black, err2 := GetStoredGame1().GetBlackAddress()
@@ -90,7 +97,7 @@ func TestGetPlayerAddressBlackIncorrect(t *testing.T) {
 	black, found, err := storedGame.GetPlayerAddress("b")
 	require.Nil(t, black)
 	require.False(t, found)
-	require.EqualError(t, err, "black address is invalid: not an address: decoding bech32 failed: invalid separator index -1")
+	require.EqualError(t, err, "black address is invalid: notanaddress: decoding bech32 failed: invalid separator index -1")
 }
 
 func TestGetPlayerAddressRedCorrect(t *testing.T) {
@@ -107,7 +114,7 @@ func TestGetPlayerAddressRedIncorrect(t *testing.T) {
 	red, found, err := storedGame.GetPlayerAddress("r")
 	require.Nil(t, red)
 	require.False(t, found)
-	require.EqualError(t, err, "red address is invalid: not an address: decoding bech32 failed: invalid separator index -1")
+	require.EqualError(t, err, "red address is invalid: notanaddress: decoding bech32 failed: invalid separator index -1")
 }
 
 func TestGetPlayerAddressAnyNotFound(t *testing.T) {
@@ -143,3 +150,67 @@ func TestGetWinnerNotYetCorrect(t *testing.T) {
 	require.False(t, found)
 	require.Nil(t, err)
 }
+
+func TestGetNextDeadlineTurnDuration(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.TimeControl = types.TimeControl{TurnDuration: time.Minute}
+	now := time.Date(2026, time.March, 1, 10, 0, 0, 0, time.UTC)
+	ctx := sdk.Context{}.WithBlockTime(now)
+	require.Equal(t, now.Add(time.Minute), storedGame.GetNextDeadline(ctx))
+}
+
+func TestGetNextDeadlineClockOfPlayerToMove(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.TimeControl = types.TimeControl{Clock: 10 * time.Minute}
+	storedGame.BlackClock = 3 * time.Minute
+	storedGame.RedClock = 7 * time.Minute
+	now := time.Date(2026, time.March, 1, 10, 0, 0, 0, time.UTC)
+	ctx := sdk.Context{}.WithBlockTime(now)
+	require.Equal(t, now.Add(3*time.Minute), storedGame.GetNextDeadline(ctx))
+	storedGame.Turn = "r"
+	require.Equal(t, now.Add(7*time.Minute), storedGame.GetNextDeadline(ctx))
+}
+
+func TestStopClockTakesTimeSpentAndAddsIncrement(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.TimeControl = types.TimeControl{Clock: 10 * time.Minute, Increment: 5 * time.Second}
+	storedGame.BlackClock = 10 * time.Minute
+	storedGame.RedClock = 10 * time.Minute
+	now := time.Date(2026, time.March, 1, 10, 0, 0, 0, time.UTC)
+	storedGame.Deadline = types.FormatDeadline(now.Add(10 * time.Minute))
+	ctx := sdk.Context{}.WithBlockTime(now.Add(time.Minute))
+	require.Nil(t, storedGame.StopClock(ctx, true))
+	require.Equal(t, 9*time.Minute+5*time.Second, storedGame.BlackClock)
+	require.Equal(t, 10*time.Minute, storedGame.RedClock)
+}
+
+func TestStopClockWithoutIncrementWhenTurnNotOver(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.TimeControl = types.TimeControl{Clock: 10 * time.Minute, Increment: 5 * time.Second}
+	now := time.Date(2026, time.March, 1, 10, 0, 0, 0, time.UTC)
+	storedGame.Deadline = types.FormatDeadline(now.Add(10 * time.Minute))
+	ctx := sdk.Context{}.WithBlockTime(now.Add(time.Minute))
+	require.Nil(t, storedGame.StopClock(ctx, false))
+	require.Equal(t, 9*time.Minute, storedGame.BlackClock)
+}
+
+func TestStopClockLeavesTurnDurationGame(t *testing.T) {
+	storedGame := GetStoredGame1()
+	storedGame.TimeControl = types.TimeControl{TurnDuration: time.Minute}
+	require.Nil(t, storedGame.StopClock(sdk.Context{}, true))
+	require.Equal(t, time.Duration(0), storedGame.BlackClock)
+}
+
+func TestTimeControlCheckBounds(t *testing.T) {
+	require.Nil(t, types.TimeControl{TurnDuration: time.Minute}.CheckBounds(time.Minute, time.Hour))
+	require.Nil(t, types.TimeControl{Clock: time.Hour, Increment: time.Minute}.CheckBounds(time.Minute, time.Hour))
+	require.EqualError(t,
+		types.TimeControl{TurnDuration: time.Second}.CheckBounds(time.Minute, time.Hour),
+		"1s not within 1m0s and 1h0m0s: time control out of bounds")
+	require.EqualError(t,
+		types.TimeControl{Clock: 2 * time.Hour}.CheckBounds(time.Minute, time.Hour),
+		"2h0m0s not within 1m0s and 1h0m0s: time control out of bounds")
+	require.EqualError(t,
+		types.TimeControl{Clock: time.Hour, Increment: 2 * time.Hour}.CheckBounds(time.Minute, time.Hour),
+		"increment 2h0m0s longer than 1h0m0s: time control out of bounds")
+}
@@ -11,11 +11,17 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		SystemInfo: SystemInfo{
-			NextId:        uint64(DefaultIndex),
-			FifoHeadIndex: NoFifoIndex,
-			FifoTailIndex: NoFifoIndex,
+			NextId: uint64(DefaultIndex),
 		},
 		StoredGameList: []StoredGame{},
+		PlayerInfoList: []PlayerInfo{},
+		Leaderboard: Leaderboard{
+			Winners: []WinningPlayer{},
+		},
+		GameMoveList:             []GameMove{},
+		ChallengeList:            []Challenge{},
+		TournamentList:           []Tournament{},
+		LeaderboardCandidateList: []string{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -26,6 +32,7 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// Check for duplicated index in storedGame
 	storedGameIndexMap := make(map[string]struct{})
+	moveCounts := make(map[string]uint64)
 
 	for _, elem := range gs.StoredGameList {
 		index := string(StoredGameKey(elem.Index))
@@ -33,8 +40,95 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("duplicated index for storedGame")
 		}
 		storedGameIndexMap[index] = struct{}{}
+		moveCounts[elem.Index] = elem.MoveCount
+	}
+	// Check that each gameMove is logged once, within the moves of its storedGame
+	gameMoveIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.GameMoveList {
+		moveCount, found := moveCounts[elem.GameIndex]
+		if !found {
+			return fmt.Errorf("gameMove of unknown storedGame: %s", elem.GameIndex)
+		}
+		if moveCount <= elem.MoveIndex {
+			return fmt.Errorf("gameMove %d beyond the move count of storedGame %s", elem.MoveIndex, elem.GameIndex)
+		}
+		index := string(append(GameMovesKeyPrefix(elem.GameIndex), GameMoveKey(elem.MoveIndex)...))
+		if _, ok := gameMoveIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for gameMove")
+		}
+		gameMoveIndexMap[index] = struct{}{}
+	}
+	// Check that each challenge has its own number as index, which no storedGame has taken yet
+	challengeIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ChallengeList {
+		key, ok := ChallengeKey(elem.Index)
+		if !ok {
+			return fmt.Errorf("challenge index is not a number: %s", elem.Index)
+		}
+		index := string(key)
+		if _, ok := challengeIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for challenge")
+		}
+		challengeIndexMap[index] = struct{}{}
+		if _, ok := moveCounts[elem.Index]; ok {
+			return fmt.Errorf("challenge index taken by storedGame: %s", elem.Index)
+		}
+		if _, err := elem.GetDeadlineAsTime(); err != nil {
+			return err
+		}
+	}
+	// Check that each tournament has its own number as index, which no storedGame nor challenge
+	// has taken yet
+	tournamentIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.TournamentList {
+		key, ok := TournamentKey(elem.Index)
+		if !ok {
+			return fmt.Errorf("tournament index is not a number: %s", elem.Index)
+		}
+		index := string(key)
+		if _, ok := tournamentIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for tournament")
+		}
+		tournamentIndexMap[index] = struct{}{}
+		if _, ok := moveCounts[elem.Index]; ok {
+			return fmt.Errorf("tournament index taken by storedGame: %s", elem.Index)
+		}
+		if _, ok := challengeIndexMap[index]; ok {
+			return fmt.Errorf("tournament index taken by challenge: %s", elem.Index)
+		}
+		if _, err := elem.GetStartTimeAsTime(); err != nil {
+			return err
+		}
+	}
+	// Check for duplicated index in playerInfo
+	playerInfoIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.PlayerInfoList {
+		index := string(PlayerInfoKey(elem.Index))
+		if _, ok := playerInfoIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for playerInfo")
+		}
+		playerInfoIndexMap[index] = struct{}{}
+	}
+	// Check that each leaderboardCandidate is listed once, with a playerInfo
+	leaderboardCandidateMap := make(map[string]struct{})
+
+	for _, elem := range gs.LeaderboardCandidateList {
+		if _, ok := playerInfoIndexMap[string(PlayerInfoKey(elem))]; !ok {
+			return fmt.Errorf("leaderboardCandidate without playerInfo: %s", elem)
+		}
+		if _, ok := leaderboardCandidateMap[elem]; ok {
+			return fmt.Errorf("duplicated leaderboardCandidate: %s", elem)
+		}
+		leaderboardCandidateMap[elem] = struct{}{}
 	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
+	if err := gs.Leaderboard.Validate(); err != nil {
+		return err
+	}
 	return gs.Params.Validate()
 }
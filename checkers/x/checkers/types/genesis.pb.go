@@ -28,6 +28,19 @@ type GenesisState struct {
 	Params         Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	SystemInfo     SystemInfo   `protobuf:"bytes,2,opt,name=systemInfo,proto3" json:"systemInfo"`
 	StoredGameList []StoredGame `protobuf:"bytes,3,rep,name=storedGameList,proto3" json:"storedGameList"`
+	PlayerInfoList []PlayerInfo `protobuf:"bytes,4,rep,name=playerInfoList,proto3" json:"playerInfoList"`
+	Leaderboard    Leaderboard  `protobuf:"bytes,5,opt,name=leaderboard,proto3" json:"leaderboard"`
+	// The move logs of all stored games, game after game.
+	GameMoveList []GameMove `protobuf:"bytes,6,rep,name=gameMoveList,proto3" json:"gameMoveList"`
+	// The open challenges, whose creators' stakes are in escrow. Their indices were taken from
+	// systemInfo's nextId, like those of games.
+	ChallengeList []Challenge `protobuf:"bytes,7,rep,name=challengeList,proto3" json:"challengeList"`
+	// All tournaments, their indices also taken from nextId. The start time index of the open ones
+	// is rebuilt from them.
+	TournamentList []Tournament `protobuf:"bytes,8,rep,name=tournamentList,proto3" json:"tournamentList"`
+	// The addresses of the players whose record changed since the leaderboard was last refreshed,
+	// each with a playerInfo.
+	LeaderboardCandidateList []string `protobuf:"bytes,9,rep,name=leaderboardCandidateList,proto3" json:"leaderboardCandidateList,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,6 +97,48 @@ func (m *GenesisState) GetStoredGameList() []StoredGame {
 	return nil
 }
 
+func (m *GenesisState) GetPlayerInfoList() []PlayerInfo {
+	if m != nil {
+		return m.PlayerInfoList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLeaderboard() Leaderboard {
+	if m != nil {
+		return m.Leaderboard
+	}
+	return Leaderboard{}
+}
+
+func (m *GenesisState) GetGameMoveList() []GameMove {
+	if m != nil {
+		return m.GameMoveList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChallengeList() []Challenge {
+	if m != nil {
+		return m.ChallengeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTournamentList() []Tournament {
+	if m != nil {
+		return m.TournamentList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLeaderboardCandidateList() []string {
+	if m != nil {
+		return m.LeaderboardCandidateList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "alice.checkers.checkers.GenesisState")
 }
@@ -91,24 +146,35 @@ func init() {
 func init() { proto.RegisterFile("checkers/genesis.proto", fileDescriptor_6e928243c164a8dc) }
 
 var fileDescriptor_6e928243c164a8dc = []byte{
-	// 268 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0xce, 0x48, 0x4d,
-	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x12, 0x4f, 0xcc, 0xc9, 0x4c, 0x4e, 0xd5, 0x83, 0xc9, 0xc2, 0x19, 0x52, 0x22,
-	0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x35, 0xfa, 0x20, 0x16, 0x44, 0xb9, 0x94, 0x28, 0xdc, 0x98, 0x82,
-	0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0x29, 0x52, 0x52, 0x70, 0xe1, 0xe2, 0xca, 0xe2, 0x92, 0xd4, 0xdc,
-	0xf8, 0xcc, 0xbc, 0xb4, 0x7c, 0x4c, 0xb9, 0x92, 0xfc, 0xa2, 0xd4, 0x94, 0xf8, 0xf4, 0xc4, 0xdc,
-	0x54, 0x88, 0x9c, 0xd2, 0x6b, 0x46, 0x2e, 0x1e, 0x77, 0x88, 0x7b, 0x82, 0x4b, 0x12, 0x4b, 0x52,
-	0x85, 0x6c, 0xb9, 0xd8, 0x20, 0x06, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xc9, 0xeb, 0xe1,
-	0x70, 0x9f, 0x5e, 0x00, 0x58, 0x99, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x4d, 0x42,
-	0x9e, 0x5c, 0x5c, 0x10, 0x07, 0x78, 0xe6, 0xa5, 0xe5, 0x4b, 0x30, 0x81, 0x8d, 0x50, 0xc6, 0x69,
-	0x44, 0x30, 0x5c, 0x29, 0xd4, 0x18, 0x24, 0xcd, 0x42, 0x81, 0x5c, 0x7c, 0x10, 0xf7, 0xba, 0x27,
-	0xe6, 0xa6, 0xfa, 0x64, 0x16, 0x97, 0x48, 0x30, 0x2b, 0x30, 0xe3, 0x37, 0x0e, 0xae, 0x1c, 0x6a,
-	0x1c, 0x9a, 0x01, 0x4e, 0x2e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91,
-	0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5,
-	0x95, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f, 0x36, 0x5e, 0x1f, 0x1e,
-	0x68, 0x15, 0x08, 0x66, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xe8, 0x8c, 0x01, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xae, 0x49, 0x97, 0x2b, 0xd2, 0x01, 0x00, 0x00,
+	// 442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x13, 0xba, 0x15, 0xe6, 0x0d, 0x0e, 0x16, 0x7f, 0x42, 0x0e, 0x59, 0x19, 0x1c, 0x26,
+	0x0e, 0x89, 0x04, 0x37, 0x24, 0x2e, 0x1b, 0x52, 0x35, 0x51, 0xd0, 0xd8, 0x38, 0x71, 0xa9, 0xdc,
+	0xe4, 0x6d, 0x1a, 0x11, 0xc7, 0x91, 0xe3, 0x56, 0xf4, 0x5b, 0xf0, 0x71, 0xf8, 0x08, 0x3d, 0xf6,
+	0xc8, 0x09, 0xa1, 0xf6, 0x8b, 0xa0, 0xd8, 0x8e, 0x93, 0x50, 0x42, 0x6f, 0x56, 0x9e, 0xe7, 0xf9,
+	0xc5, 0xef, 0x63, 0x1b, 0x3d, 0x0e, 0x67, 0x10, 0x7e, 0x05, 0x5e, 0x04, 0x31, 0x64, 0x50, 0x24,
+	0x85, 0x9f, 0x73, 0x26, 0x18, 0x7e, 0x42, 0xd2, 0x24, 0x04, 0xbf, 0x52, 0xcd, 0xc2, 0x7d, 0x18,
+	0xb3, 0x98, 0x49, 0x4f, 0x50, 0xae, 0x94, 0xdd, 0x7d, 0x64, 0x30, 0x39, 0xe1, 0x84, 0x6a, 0x8a,
+	0xeb, 0x9a, 0xcf, 0xc5, 0xb2, 0x10, 0x40, 0xc7, 0x49, 0x36, 0x65, 0xbb, 0x9a, 0x60, 0x1c, 0xa2,
+	0x71, 0x4c, 0x28, 0xec, 0x68, 0x79, 0x4a, 0x96, 0xc0, 0xff, 0x9d, 0x4b, 0x81, 0x44, 0xc0, 0x27,
+	0x8c, 0xf0, 0x48, 0x6b, 0x4e, 0x3d, 0x0d, 0xa1, 0x30, 0xa6, 0x6c, 0x01, 0x3b, 0x4a, 0x38, 0x23,
+	0x69, 0x0a, 0x59, 0x5c, 0x29, 0x4f, 0x8d, 0x22, 0xd8, 0x9c, 0x67, 0x84, 0x42, 0x26, 0x94, 0x74,
+	0xf6, 0xe3, 0x10, 0x9d, 0x0c, 0x55, 0x2d, 0xb7, 0x82, 0x08, 0xc0, 0x6f, 0x51, 0x5f, 0xcd, 0xe7,
+	0xd8, 0x03, 0xfb, 0xfc, 0xf8, 0xd5, 0xa9, 0xdf, 0x51, 0x93, 0x7f, 0x2d, 0x6d, 0x17, 0x07, 0xab,
+	0x5f, 0xa7, 0xd6, 0x8d, 0x0e, 0xe1, 0x2b, 0x84, 0x54, 0x0f, 0x57, 0xd9, 0x94, 0x39, 0x77, 0x24,
+	0xe2, 0x79, 0x27, 0xe2, 0xd6, 0x58, 0x35, 0xa6, 0x11, 0xc6, 0x9f, 0xd0, 0x03, 0x55, 0xdb, 0x90,
+	0x50, 0x18, 0x25, 0x85, 0x70, 0x7a, 0x83, 0xde, 0xff, 0x71, 0xc6, 0xae, 0x71, 0x7f, 0x01, 0x4a,
+	0xa4, 0x6a, 0xbb, 0xfc, 0x81, 0x44, 0x1e, 0xec, 0x41, 0x5e, 0x1b, 0x7b, 0x85, 0x6c, 0x03, 0xf0,
+	0x08, 0x1d, 0x37, 0x0e, 0xc9, 0x39, 0x94, 0x13, 0xbf, 0xe8, 0xe4, 0x8d, 0x6a, 0xaf, 0x06, 0x36,
+	0xe3, 0xf8, 0x3d, 0x3a, 0x29, 0x8f, 0xf5, 0x03, 0x5b, 0xa8, 0x89, 0xfb, 0x72, 0x7b, 0xcf, 0x3a,
+	0x71, 0x43, 0x6d, 0xd6, 0xac, 0x56, 0x18, 0x7f, 0x44, 0xf7, 0xcd, 0x4d, 0x90, 0xb4, 0xbb, 0x92,
+	0x76, 0xd6, 0x49, 0xbb, 0xac, 0xdc, 0x1a, 0xd7, 0x8e, 0x97, 0xed, 0xd5, 0xf7, 0x47, 0x02, 0xef,
+	0xed, 0x69, 0xef, 0xb3, 0xb1, 0x57, 0xed, 0xb5, 0x01, 0xf8, 0x0d, 0x72, 0x1a, 0xe3, 0x5f, 0x92,
+	0x2c, 0x4a, 0x22, 0x22, 0xd4, 0x6e, 0x8f, 0x06, 0xbd, 0xf3, 0xa3, 0x9b, 0x4e, 0xfd, 0xe2, 0xdd,
+	0x6a, 0xe3, 0xd9, 0xeb, 0x8d, 0x67, 0xff, 0xde, 0x78, 0xf6, 0xf7, 0xad, 0x67, 0xad, 0xb7, 0x9e,
+	0xf5, 0x73, 0xeb, 0x59, 0x5f, 0x5e, 0xc6, 0x89, 0x98, 0xcd, 0x27, 0x7e, 0xc8, 0x68, 0x20, 0xb7,
+	0x16, 0x98, 0x07, 0xf0, 0xad, 0x5e, 0x8a, 0x65, 0x0e, 0xc5, 0xa4, 0x2f, 0xdf, 0xc1, 0xeb, 0x3f,
+	0x03, 0x00, 0x31, 0x9e, 0x53, 0xd2, 0x26, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -131,6 +197,81 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LeaderboardCandidateList) > 0 {
+		for iNdEx := len(m.LeaderboardCandidateList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LeaderboardCandidateList[iNdEx])
+			copy(dAtA[i:], m.LeaderboardCandidateList[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.LeaderboardCandidateList[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.TournamentList) > 0 {
+		for iNdEx := len(m.TournamentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TournamentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ChallengeList) > 0 {
+		for iNdEx := len(m.ChallengeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChallengeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.GameMoveList) > 0 {
+		for iNdEx := len(m.GameMoveList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GameMoveList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Leaderboard.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.PlayerInfoList) > 0 {
+		for iNdEx := len(m.PlayerInfoList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlayerInfoList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.StoredGameList) > 0 {
 		for iNdEx := len(m.StoredGameList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -195,6 +336,38 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PlayerInfoList) > 0 {
+		for _, e := range m.PlayerInfoList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Leaderboard.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.GameMoveList) > 0 {
+		for _, e := range m.GameMoveList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChallengeList) > 0 {
+		for _, e := range m.ChallengeList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TournamentList) > 0 {
+		for _, e := range m.TournamentList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LeaderboardCandidateList) > 0 {
+		for _, s := range m.LeaderboardCandidateList {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -333,6 +506,207 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayerInfoList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlayerInfoList = append(m.PlayerInfoList, PlayerInfo{})
+			if err := m.PlayerInfoList[len(m.PlayerInfoList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaderboard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Leaderboard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GameMoveList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GameMoveList = append(m.GameMoveList, GameMove{})
+			if err := m.GameMoveList[len(m.GameMoveList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengeList = append(m.ChallengeList, Challenge{})
+			if err := m.ChallengeList[len(m.ChallengeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TournamentList = append(m.TournamentList, Tournament{})
+			if err := m.TournamentList[len(m.TournamentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderboardCandidateList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaderboardCandidateList = append(m.LeaderboardCandidateList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,7 +1,9 @@
 package types_test
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	"github.com/alice/checkers/x/checkers/types"
 	"github.com/stretchr/testify/require"
@@ -21,11 +23,20 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state",
 			genState: &types.GenesisState{
-
+				Params: types.DefaultParams(),
 				SystemInfo: types.SystemInfo{
 					NextId: 20,
 				},
 				StoredGameList: []types.StoredGame{
+					{
+						Index:     "0",
+						MoveCount: 2,
+					},
+					{
+						Index: "1",
+					},
+				},
+				PlayerInfoList: []types.PlayerInfo{
 					{
 						Index: "0",
 					},
@@ -33,6 +44,33 @@ func TestGenesisState_Validate(t *testing.T) {
 						Index: "1",
 					},
 				},
+				GameMoveList: []types.GameMove{
+					{
+						GameIndex: "0",
+						MoveIndex: 0,
+					},
+					{
+						GameIndex: "0",
+						MoveIndex: 1,
+					},
+				},
+				ChallengeList: []types.Challenge{
+					{
+						Index:    "18",
+						Deadline: types.DeadlineLayout,
+					},
+					{
+						Index:    "19",
+						Deadline: types.DeadlineLayout,
+					},
+				},
+				TournamentList: []types.Tournament{
+					{
+						Index:     "17",
+						StartTime: types.DeadlineLayout,
+					},
+				},
+				LeaderboardCandidateList: []string{"0", "1"},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -51,6 +89,346 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated playerInfo",
+			genState: &types.GenesisState{
+				PlayerInfoList: []types.PlayerInfo{
+					{
+						Index: "0",
+					},
+					{
+						Index: "0",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "leaderboardCandidate without playerInfo",
+			genState: &types.GenesisState{
+				PlayerInfoList: []types.PlayerInfo{
+					{
+						Index: "0",
+					},
+				},
+				LeaderboardCandidateList: []string{"1"},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated leaderboardCandidate",
+			genState: &types.GenesisState{
+				PlayerInfoList: []types.PlayerInfo{
+					{
+						Index: "0",
+					},
+				},
+				LeaderboardCandidateList: []string{"0", "0"},
+			},
+			valid: false,
+		},
+		{
+			desc: "gameMove of unknown storedGame",
+			genState: &types.GenesisState{
+				GameMoveList: []types.GameMove{
+					{
+						GameIndex: "0",
+						MoveIndex: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "gameMove beyond move count",
+			genState: &types.GenesisState{
+				StoredGameList: []types.StoredGame{
+					{
+						Index:     "0",
+						MoveCount: 1,
+					},
+				},
+				GameMoveList: []types.GameMove{
+					{
+						GameIndex: "0",
+						MoveIndex: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated gameMove",
+			genState: &types.GenesisState{
+				StoredGameList: []types.StoredGame{
+					{
+						Index:     "0",
+						MoveCount: 2,
+					},
+				},
+				GameMoveList: []types.GameMove{
+					{
+						GameIndex: "0",
+						MoveIndex: 1,
+					},
+					{
+						GameIndex: "0",
+						MoveIndex: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "challenge index not a number",
+			genState: &types.GenesisState{
+				ChallengeList: []types.Challenge{
+					{
+						Index:    "a",
+						Deadline: types.DeadlineLayout,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated challenge",
+			genState: &types.GenesisState{
+				ChallengeList: []types.Challenge{
+					{
+						Index:    "1",
+						Deadline: types.DeadlineLayout,
+					},
+					{
+						Index:    "1",
+						Deadline: types.DeadlineLayout,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "challenge index taken by storedGame",
+			genState: &types.GenesisState{
+				StoredGameList: []types.StoredGame{
+					{
+						Index: "1",
+					},
+				},
+				ChallengeList: []types.Challenge{
+					{
+						Index:    "1",
+						Deadline: types.DeadlineLayout,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "challenge deadline not parsable",
+			genState: &types.GenesisState{
+				ChallengeList: []types.Challenge{
+					{
+						Index:    "1",
+						Deadline: "tomorrow",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "tournament index not a number",
+			genState: &types.GenesisState{
+				TournamentList: []types.Tournament{
+					{
+						Index:     "a",
+						StartTime: types.DeadlineLayout,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated tournament",
+			genState: &types.GenesisState{
+				TournamentList: []types.Tournament{
+					{
+						Index:     "1",
+						StartTime: types.DeadlineLayout,
+					},
+					{
+						Index:     "1",
+						StartTime: types.DeadlineLayout,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "tournament index taken by storedGame",
+			genState: &types.GenesisState{
+				StoredGameList: []types.StoredGame{
+					{
+						Index: "1",
+					},
+				},
+				TournamentList: []types.Tournament{
+					{
+						Index:     "1",
+						StartTime: types.DeadlineLayout,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "tournament index taken by challenge",
+			genState: &types.GenesisState{
+				ChallengeList: []types.Challenge{
+					{
+						Index:    "1",
+						Deadline: types.DeadlineLayout,
+					},
+				},
+				TournamentList: []types.Tournament{
+					{
+						Index:     "1",
+						StartTime: types.DeadlineLayout,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "tournament start time not parsable",
+			genState: &types.GenesisState{
+				TournamentList: []types.Tournament{
+					{
+						Index:     "1",
+						StartTime: "tomorrow",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated leaderboard player",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Leaderboard: types.Leaderboard{
+					Winners: []types.WinningPlayer{
+						{PlayerAddress: alice, DateAdded: types.DeadlineLayout},
+						{PlayerAddress: alice, DateAdded: types.DeadlineLayout},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero leaderboard length",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"stake"}, time.Minute, 0, 0, 0, time.Minute, time.Minute, 0, 1, 0, 40, time.Hour, 1, 1),
+			},
+			valid: false,
+		},
+		{
+			desc: "zero leaderboard refresh interval",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"stake"}, time.Minute, 0, 0, 0, time.Minute, time.Minute, 1, 0, 0, 40, time.Hour, 1, 1),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid allowed denom",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"1stake"}, types.DefaultMaxTurnDuration, types.DefaultCreateGameGas, types.DefaultPlayMoveGas, types.DefaultRejectGameRefundGas, types.DefaultMinTimeControl, types.DefaultMaxTimeControl, types.DefaultLeaderboardLength, types.DefaultLeaderboardRefreshInterval, types.DefaultMaxTakebacks, types.DefaultMaxMovesWithoutProgress, types.DefaultMaxChallengeDuration, types.DefaultMaxForfeitsPerBlock, types.DefaultMaxChallengeExpiriesPerBlock),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated allowed denom",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"stake", "stake"}, types.DefaultMaxTurnDuration, types.DefaultCreateGameGas, types.DefaultPlayMoveGas, types.DefaultRejectGameRefundGas, types.DefaultMinTimeControl, types.DefaultMaxTimeControl, types.DefaultLeaderboardLength, types.DefaultLeaderboardRefreshInterval, types.DefaultMaxTakebacks, types.DefaultMaxMovesWithoutProgress, types.DefaultMaxChallengeDuration, types.DefaultMaxForfeitsPerBlock, types.DefaultMaxChallengeExpiriesPerBlock),
+			},
+			valid: false,
+		},
+		{
+			desc: "zero max turn duration",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"stake"}, 0, types.DefaultCreateGameGas, types.DefaultPlayMoveGas, types.DefaultRejectGameRefundGas, types.DefaultMinTimeControl, types.DefaultMaxTimeControl, types.DefaultLeaderboardLength, types.DefaultLeaderboardRefreshInterval, types.DefaultMaxTakebacks, types.DefaultMaxMovesWithoutProgress, types.DefaultMaxChallengeDuration, types.DefaultMaxForfeitsPerBlock, types.DefaultMaxChallengeExpiriesPerBlock),
+			},
+			valid: false,
+		},
+		{
+			desc: "no gas charged",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"stake"}, time.Minute, 0, 0, 0, time.Minute, time.Minute, 1, 1, 0, 40, time.Hour, 1, 1),
+			},
+			valid: true,
+		},
+		{
+			desc: "zero min time control",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"stake"}, time.Minute, 0, 0, 0, 0, time.Hour, 1, 1, 0, 40, time.Hour, 1, 1),
+			},
+			valid: false,
+		},
+		{
+			desc: "max turn duration below min time control",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"stake"}, time.Minute, 0, 0, 0, time.Hour, 2*time.Hour, 1, 1, 0, 40, time.Hour, 1, 1),
+			},
+			valid: false,
+		},
+		{
+			desc: "max turn duration above max time control",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"stake"}, time.Hour, 0, 0, 0, time.Second, time.Minute, 1, 1, 0, 40, time.Hour, 1, 1),
+			},
+			valid: false,
+		},
+		{
+			desc: "zero max moves without progress",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"stake"}, time.Minute, 0, 0, 0, time.Minute, time.Minute, 1, 1, 0, 0, time.Hour, 1, 1),
+			},
+			valid: false,
+		},
+		{
+			desc: "max moves without progress too large",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"stake"}, time.Minute, 0, 0, 0, time.Minute, time.Minute, 1, 1, 0, math.MaxInt32+1, time.Hour, 1, 1),
+			},
+			valid: false,
+		},
+		{
+			desc: "zero max challenge duration",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"stake"}, time.Minute, 0, 0, 0, time.Minute, time.Minute, 1, 1, 0, 40, 0, 1, 1),
+			},
+			valid: false,
+		},
+		{
+			desc: "zero max forfeits per block",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"stake"}, time.Minute, 0, 0, 0, time.Minute, time.Minute, 1, 1, 0, 40, time.Hour, 0, 1),
+			},
+			valid: false,
+		},
+		{
+			desc: "zero max challenge expiries per block",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"stake"}, time.Minute, 0, 0, 0, time.Minute, time.Minute, 1, 1, 0, 40, time.Hour, 1, 0),
+			},
+			valid: false,
+		},
+		{
+			desc: "max forfeits per block too large",
+			genState: &types.GenesisState{
+				Params: types.NewParams([]string{"stake"}, time.Minute, 0, 0, 0, time.Minute, time.Minute, 1, 1, 0, 40, time.Hour, math.MaxInt32+1, 1),
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -69,11 +447,33 @@ func TestGenesisState_Validate(t *testing.T) {
 func TestDefaultGenesisState_ExpectedInitialNextId(t *testing.T) {
 	require.EqualValues(t,
 		&types.GenesisState{
+			Params: types.Params{
+				AllowedDenoms:                []string{"stake"},
+				MaxTurnDuration:              5 * time.Minute,
+				CreateGameGas:                15000,
+				PlayMoveGas:                  1000,
+				RejectGameRefundGas:          14000,
+				MinTimeControl:               30 * time.Second,
+				MaxTimeControl:               7 * 24 * time.Hour,
+				LeaderboardLength:            100,
+				LeaderboardRefreshInterval:   100,
+				MaxTakebacks:                 3,
+				MaxMovesWithoutProgress:      40,
+				MaxChallengeDuration:         24 * time.Hour,
+				MaxForfeitsPerBlock:          100,
+				MaxChallengeExpiriesPerBlock: 100,
+			},
 			StoredGameList: []types.StoredGame{},
+			PlayerInfoList: []types.PlayerInfo{},
+			Leaderboard: types.Leaderboard{
+				Winners: []types.WinningPlayer{},
+			},
+			GameMoveList:             []types.GameMove{},
+			ChallengeList:            []types.Challenge{},
+			TournamentList:           []types.Tournament{},
+			LeaderboardCandidateList: []string{},
 			SystemInfo: types.SystemInfo{
-				NextId:        uint64(1),
-				FifoHeadIndex: "-1",
-				FifoTailIndex: "-1",
+				NextId: uint64(1),
 			},
 		},
 		types.DefaultGenesis())
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"encoding/binary"
+	"strconv"
+)
+
+const (
+	// ChallengeKeyPrefix is the prefix to retrieve all Challenge
+	ChallengeKeyPrefix = "Challenge/value/"
+)
+
+// ChallengeKey returns the store key to retrieve a Challenge from its index. Indices are
+// numbers, kept big-endian so that challenges iterate oldest first.
+func ChallengeKey(
+	index string,
+) (key []byte, ok bool) {
+	id, err := strconv.ParseUint(index, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	key = make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key, true
+}
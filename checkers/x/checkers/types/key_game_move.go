@@ -0,0 +1,31 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// GameMoveKeyPrefix is the prefix to retrieve all GameMove
+	GameMoveKeyPrefix = "GameMove/value/"
+)
+
+// GameMovesKeyPrefix returns the store prefix under which the moves of a game are kept
+func GameMovesKeyPrefix(
+	gameIndex string,
+) []byte {
+	var key []byte
+
+	key = append(key, KeyPrefix(GameMoveKeyPrefix)...)
+	key = append(key, []byte(gameIndex)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// GameMoveKey returns the store key to retrieve a GameMove within its game's prefix.
+// The move index is big-endian so that moves iterate in the order they were played.
+func GameMoveKey(
+	moveIndex uint64,
+) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, moveIndex)
+	return key
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+var _ binary.ByteOrder
+
+const (
+	// PlayerInfoKeyPrefix is the prefix to retrieve all PlayerInfo
+	PlayerInfoKeyPrefix = "PlayerInfo/value/"
+	// PlayerInfoByRatingKeyPrefix is the prefix of the index of players, best first
+	PlayerInfoByRatingKeyPrefix = "PlayerInfo/rating/"
+)
+
+// PlayerInfoKey returns the store key to retrieve a PlayerInfo from the index fields
+func PlayerInfoKey(
+	index string,
+) []byte {
+	var key []byte
+
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// PlayerInfoByRatingKey returns the key of a player in the rating index. The rating and won count
+// are written complemented, so that players iterate best rating first, then most wins.
+func PlayerInfoByRatingKey(
+	playerInfo PlayerInfo,
+) []byte {
+	var key []byte
+
+	ratingBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(ratingBytes, math.MaxUint64-playerInfo.EloRating)
+	key = append(key, ratingBytes...)
+	wonBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(wonBytes, math.MaxUint64-playerInfo.WonCount)
+	key = append(key, wonBytes...)
+	key = append(key, []byte("/")...)
+	indexBytes := []byte(playerInfo.Index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -1,12 +1,27 @@
 package types
 
-import "encoding/binary"
+import (
+	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 var _ binary.ByteOrder
 
 const (
 	// StoredGameKeyPrefix is the prefix to retrieve all StoredGame
 	StoredGameKeyPrefix = "StoredGame/value/"
+	// StoredGameByDeadlineKeyPrefix is the prefix of the index of ongoing games by deadline
+	StoredGameByDeadlineKeyPrefix = "StoredGame/deadline/"
+	// StoredGameByPlayerKeyPrefix is the prefix of the index of games by player address
+	StoredGameByPlayerKeyPrefix = "StoredGame/player/"
+	// StoredGameListingKeyPrefix is the prefix of the index of games by status and turn, in listing
+	// order
+	StoredGameListingKeyPrefix = "StoredGame/listing/"
+	// StoredGameWagerListingKeyPrefix is the prefix of the same index with games by wager first, so
+	// that a wager range is a range of keys
+	StoredGameWagerListingKeyPrefix = "StoredGame/wagerListing/"
 )
 
 // StoredGameKey returns the store key to retrieve a StoredGame from the index fields
@@ -21,3 +36,91 @@ func StoredGameKey(
 
 	return key
 }
+
+// StoredGameByDeadlineKey returns the key of a game in the deadline index. Deadlines are written
+// in a sortable layout of fixed length, so that games iterate soonest deadline first.
+func StoredGameByDeadlineKey(
+	deadline time.Time,
+	index string,
+) []byte {
+	var key []byte
+
+	deadlineBytes := sdk.FormatTimeBytes(deadline)
+	key = append(key, deadlineBytes...)
+	key = append(key, []byte("/")...)
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// StoredGameByPlayerPrefix returns the prefix of the keys of a player's games in the player index.
+func StoredGameByPlayerPrefix(
+	player string,
+) []byte {
+	var key []byte
+
+	playerBytes := []byte(player)
+	key = append(key, playerBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// StoredGameByPlayerKey returns the key of a player's game in the player index.
+func StoredGameByPlayerKey(
+	player string,
+	index string,
+) []byte {
+	key := StoredGameByPlayerPrefix(player)
+
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// StoredGameListingPrefix returns the prefix of the keys of the games with a listing status and
+// color to play, in the given order, in the listing index. The empty status is that of all games,
+// the empty turn that of either color.
+func StoredGameListingPrefix(
+	sortBy string,
+	status string,
+	turn string,
+) []byte {
+	var key []byte
+
+	sortByBytes := []byte(sortBy)
+	key = append(key, sortByBytes...)
+	key = append(key, []byte("/")...)
+	statusBytes := []byte(status)
+	key = append(key, statusBytes...)
+	key = append(key, []byte("/")...)
+	turnBytes := []byte(turn)
+	key = append(key, turnBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// StoredGameListingKey returns the key of a game in the listing index. The sort key is of fixed
+// length, so that games iterate in listing order.
+func StoredGameListingKey(
+	sortBy string,
+	status string,
+	turn string,
+	sortKey []byte,
+	index string,
+) []byte {
+	key := StoredGameListingPrefix(sortBy, status, turn)
+
+	key = append(key, sortKey...)
+	key = append(key, []byte("/")...)
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
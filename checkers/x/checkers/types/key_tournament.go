@@ -0,0 +1,48 @@
+package types
+
+import (
+	"encoding/binary"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// TournamentKeyPrefix is the prefix to retrieve all Tournament
+	TournamentKeyPrefix = "Tournament/value/"
+	// TournamentByStartKeyPrefix is the prefix of the index of open tournaments by start time
+	TournamentByStartKeyPrefix = "Tournament/start/"
+)
+
+// TournamentKey returns the store key to retrieve a Tournament from its index. As with
+// challenges, indices are numbers kept big-endian so that tournaments iterate oldest first.
+func TournamentKey(
+	index string,
+) (key []byte, ok bool) {
+	id, err := strconv.ParseUint(index, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	key = make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key, true
+}
+
+// TournamentByStartKey returns the key of a tournament in the start time index, so that open
+// tournaments iterate soonest start first.
+func TournamentByStartKey(
+	startTime time.Time,
+	index string,
+) []byte {
+	var key []byte
+
+	startBytes := sdk.FormatTimeBytes(startTime)
+	key = append(key, startBytes...)
+	key = append(key, []byte("/")...)
+	indexBytes := []byte(index)
+	key = append(key, indexBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
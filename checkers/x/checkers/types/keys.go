@@ -1,9 +1,5 @@
 package types
 
-import (
-	"time"
-)
-
 const (
 	// ModuleName defines the module name
 	ModuleName = "checkers"
@@ -47,7 +43,25 @@ const (
 )
 
 const (
-	NoFifoIndex = "-1"
+	GameAcceptedEventType      = "game-accepted"
+	GameAcceptedEventCreator   = "creator"
+	GameAcceptedEventGameIndex = "game-index"
+)
+
+const (
+	// A game waits for the opponent to accept it and escrow their stake before it can be played.
+	GameStatusPending  = "pending"
+	GameStatusActive   = "active"
+	GameStatusFinished = "finished"
+	// Listing statuses narrower than finished, for the games that ended in these ways.
+	GameStatusForfeited = "forfeited"
+	GameStatusDrawn     = "drawn"
+)
+
+const (
+	// Orders in which games can be listed, the order of game indices in the store being the default.
+	GameSortByCreated  = "created"
+	GameSortByDeadline = "deadline"
 )
 
 func KeyPrefix(p string) []byte {
@@ -59,8 +73,32 @@ const (
 )
 
 const (
-	MaxTurnDuration = time.Duration(5 * 60 * 1000_000_000) // 5 minutes
-	//MaxTurnDuration = time.Duration(24 * 3_600 * 1000_000_000) // 1 day
+	LeaderboardKey = "Leaderboard-value-"
+	// Players whose record changed since the leaderboard was last refreshed.
+	LeaderboardCandidateKeyPrefix = "Leaderboard/candidate/"
+)
+
+const (
+	ChallengeCreatedEventType           = "challenge-created"
+	ChallengeCreatedEventCreator        = "creator"
+	ChallengeCreatedEventChallengeIndex = "challenge-index"
+	ChallengeCreatedEventColor          = "color"
+	ChallengeCreatedEventWager          = "wager"
+)
+
+const (
+	ChallengeAcceptedEventType           = "challenge-accepted"
+	ChallengeAcceptedEventCreator        = "creator"
+	ChallengeAcceptedEventChallengeIndex = "challenge-index"
+	ChallengeAcceptedEventGameIndex      = "game-index"
+)
+
+const (
+	ChallengeExpiredEventType           = "challenge-expired"
+	ChallengeExpiredEventChallengeIndex = "challenge-index"
+)
+
+const (
 	DeadlineLayout = "2006-01-02 15:04:05.999999999 +0000 UTC"
 )
 
@@ -72,7 +110,110 @@ const (
 )
 
 const (
-	CreateGameGas       = 15000
-	PlayMoveGas         = 1000
-	RejectGameRefundGas = 14000
+	DrawOfferedEventType      = "draw-offered"
+	DrawOfferedEventCreator   = "creator"
+	DrawOfferedEventGameIndex = "game-index"
+)
+
+const (
+	DrawDeclinedEventType      = "draw-declined"
+	DrawDeclinedEventCreator   = "creator"
+	DrawDeclinedEventGameIndex = "game-index"
+)
+
+const (
+	GameDrawnEventType      = "game-drawn"
+	GameDrawnEventCreator   = "creator"
+	GameDrawnEventGameIndex = "game-index"
+	GameDrawnEventBoard     = "board"
+)
+
+const (
+	GameResignedEventType      = "game-resigned"
+	GameResignedEventCreator   = "creator"
+	GameResignedEventGameIndex = "game-index"
+	GameResignedEventWinner    = "winner"
+	GameResignedEventBoard     = "board"
+)
+
+const (
+	TakebackRequestedEventType      = "takeback-requested"
+	TakebackRequestedEventCreator   = "creator"
+	TakebackRequestedEventGameIndex = "game-index"
+	TakebackRequestedEventPlies     = "plies"
+)
+
+const (
+	TakebackAcceptedEventType      = "takeback-accepted"
+	TakebackAcceptedEventCreator   = "creator"
+	TakebackAcceptedEventGameIndex = "game-index"
+	TakebackAcceptedEventBoard     = "board"
+)
+
+const (
+	RematchOfferedEventType      = "rematch-offered"
+	RematchOfferedEventCreator   = "creator"
+	RematchOfferedEventGameIndex = "game-index"
+)
+
+const (
+	RematchAcceptedEventType         = "rematch-accepted"
+	RematchAcceptedEventCreator      = "creator"
+	RematchAcceptedEventGameIndex    = "game-index"
+	RematchAcceptedEventRematchIndex = "rematch-index"
+)
+
+const (
+	TournamentFormatSingleElimination = "single-elimination"
+	TournamentFormatRoundRobin        = "round-robin"
+)
+
+const (
+	// Players join an open tournament until its start time. It is cancelled and the entry fees
+	// refunded if fewer than two joined.
+	TournamentStatusOpen      = "open"
+	TournamentStatusRunning   = "running"
+	TournamentStatusFinished  = "finished"
+	TournamentStatusCancelled = "cancelled"
+)
+
+const (
+	// Caps the games paired at once, as a round is paired within a single transaction or block.
+	MaxTournamentPlayers = 64
+	// Points in the standings, a bye counts as a win.
+	TournamentWinPoints  = 2
+	TournamentDrawPoints = 1
+)
+
+const (
+	TournamentCreatedEventType            = "tournament-created"
+	TournamentCreatedEventCreator         = "creator"
+	TournamentCreatedEventTournamentIndex = "tournament-index"
+	TournamentCreatedEventFormat          = "format"
+	TournamentCreatedEventEntryFee        = "entry-fee"
+	TournamentCreatedEventStartTime       = "start-time"
+)
+
+const (
+	TournamentJoinedEventType            = "tournament-joined"
+	TournamentJoinedEventCreator         = "creator"
+	TournamentJoinedEventTournamentIndex = "tournament-index"
+)
+
+const (
+	TournamentRoundStartedEventType            = "tournament-round-started"
+	TournamentRoundStartedEventTournamentIndex = "tournament-index"
+	TournamentRoundStartedEventRound           = "round"
+)
+
+const (
+	TournamentFinishedEventType            = "tournament-finished"
+	TournamentFinishedEventTournamentIndex = "tournament-index"
+	TournamentFinishedEventWinner          = "winner"
+	TournamentFinishedEventPrize           = "prize"
+)
+
+const (
+	TournamentCancelledEventType            = "tournament-cancelled"
+	TournamentCancelledEventTournamentIndex = "tournament-index"
 )
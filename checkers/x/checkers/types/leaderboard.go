@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (winner WinningPlayer) GetDateAddedAsTime() (dateAdded time.Time, err error) {
+	dateAdded, errDate := time.Parse(DeadlineLayout, winner.DateAdded)
+	return dateAdded, sdkerrors.Wrapf(errDate, ErrInvalidDateAdded.Error(), winner.DateAdded)
+}
+
+// Validate checks that each player is on the leaderboard once, with a date that can be parsed.
+func (leaderboard Leaderboard) Validate() error {
+	seen := make(map[string]bool, len(leaderboard.Winners))
+	for _, winner := range leaderboard.Winners {
+		if _, err := sdk.AccAddressFromBech32(winner.PlayerAddress); err != nil {
+			return sdkerrors.Wrapf(err, ErrInvalidLeaderboardAddress.Error(), winner.PlayerAddress)
+		}
+		if seen[winner.PlayerAddress] {
+			return fmt.Errorf("duplicated player on the leaderboard: %s", winner.PlayerAddress)
+		}
+		seen[winner.PlayerAddress] = true
+		if _, err := winner.GetDateAddedAsTime(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdatePlayers rebuilds the leaderboard from the best players as they are now, keeping the best of
+// them up to the given length. Players already on it keep the date they were added, unless their
+// record changed since.
+func (leaderboard *Leaderboard) UpdatePlayers(best []PlayerInfo, changed map[string]bool, now time.Time, length uint64) {
+	listed := make(map[string]WinningPlayer, len(leaderboard.Winners))
+	for _, winner := range leaderboard.Winners {
+		listed[winner.PlayerAddress] = winner
+	}
+
+	type dated struct {
+		winner    WinningPlayer
+		dateAdded time.Time
+	}
+	entries := make([]dated, 0, len(best))
+	for _, player := range best {
+		winner, found := listed[player.Index]
+		if !found || changed[player.Index] {
+			entries = append(entries, dated{
+				winner: WinningPlayer{
+					PlayerAddress: player.Index,
+					EloRating:     player.EloRating,
+					WonCount:      player.WonCount,
+					DateAdded:     FormatDeadline(now),
+				},
+				dateAdded: now,
+			})
+			continue
+		}
+		dateAdded, err := winner.GetDateAddedAsTime()
+		if err != nil {
+			panic(err.Error())
+		}
+		entries = append(entries, dated{winner, dateAdded})
+	}
+
+	// Best rating first, then most wins, then whoever got there first.
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].winner.EloRating != entries[j].winner.EloRating {
+			return entries[i].winner.EloRating > entries[j].winner.EloRating
+		}
+		if entries[i].winner.WonCount != entries[j].winner.WonCount {
+			return entries[i].winner.WonCount > entries[j].winner.WonCount
+		}
+		return entries[i].dateAdded.Before(entries[j].dateAdded)
+	})
+	if length < uint64(len(entries)) {
+		entries = entries[:length]
+	}
+
+	leaderboard.Winners = make([]WinningPlayer, len(entries))
+	for i, entry := range entries {
+		leaderboard.Winners[i] = entry.winner
+	}
+}
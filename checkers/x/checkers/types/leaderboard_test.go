@@ -0,0 +1,99 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alice/checkers/x/checkers/testutil"
+	"github.com/alice/checkers/x/checkers/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	leaderboardNow     = time.Date(2026, time.March, 1, 10, 0, 0, 0, time.UTC)
+	leaderboardEarlier = leaderboardNow.Add(-time.Hour)
+)
+
+func TestLeaderboardUpdatePlayersSorted(t *testing.T) {
+	leaderboard := types.Leaderboard{}
+	leaderboard.UpdatePlayers([]types.PlayerInfo{
+		{Index: alice, EloRating: 1184, WonCount: 0},
+		{Index: bob, EloRating: 1216, WonCount: 1},
+		{Index: testutil.Carol, EloRating: 1216, WonCount: 2},
+	}, nil, leaderboardNow, 10)
+	require.Equal(t, []types.WinningPlayer{
+		{PlayerAddress: testutil.Carol, EloRating: 1216, WonCount: 2, DateAdded: types.FormatDeadline(leaderboardNow)},
+		{PlayerAddress: bob, EloRating: 1216, WonCount: 1, DateAdded: types.FormatDeadline(leaderboardNow)},
+		{PlayerAddress: alice, EloRating: 1184, WonCount: 0, DateAdded: types.FormatDeadline(leaderboardNow)},
+	}, leaderboard.Winners)
+}
+
+func TestLeaderboardUpdatePlayersEarlierFirstAmongEquals(t *testing.T) {
+	leaderboard := types.Leaderboard{
+		Winners: []types.WinningPlayer{
+			{PlayerAddress: alice, EloRating: 1216, WonCount: 1, DateAdded: types.FormatDeadline(leaderboardEarlier)},
+		},
+	}
+	leaderboard.UpdatePlayers([]types.PlayerInfo{
+		{Index: bob, EloRating: 1216, WonCount: 1},
+		{Index: alice, EloRating: 1216, WonCount: 1},
+	}, map[string]bool{bob: true}, leaderboardNow, 10)
+	require.Equal(t, alice, leaderboard.Winners[0].PlayerAddress)
+	require.Equal(t, bob, leaderboard.Winners[1].PlayerAddress)
+}
+
+func TestLeaderboardUpdatePlayersReplacesEntry(t *testing.T) {
+	leaderboard := types.Leaderboard{
+		Winners: []types.WinningPlayer{
+			{PlayerAddress: alice, EloRating: 1216, WonCount: 1, DateAdded: types.FormatDeadline(leaderboardEarlier)},
+			{PlayerAddress: bob, EloRating: 1184, WonCount: 0, DateAdded: types.FormatDeadline(leaderboardEarlier)},
+		},
+	}
+	leaderboard.UpdatePlayers([]types.PlayerInfo{
+		{Index: alice, EloRating: 1199, WonCount: 1},
+		{Index: bob, EloRating: 1184, WonCount: 0},
+	}, map[string]bool{alice: true}, leaderboardNow, 10)
+	require.Equal(t, []types.WinningPlayer{
+		{PlayerAddress: alice, EloRating: 1199, WonCount: 1, DateAdded: types.FormatDeadline(leaderboardNow)},
+		{PlayerAddress: bob, EloRating: 1184, WonCount: 0, DateAdded: types.FormatDeadline(leaderboardEarlier)},
+	}, leaderboard.Winners)
+}
+
+func TestLeaderboardUpdatePlayersKeepsLength(t *testing.T) {
+	leaderboard := types.Leaderboard{}
+	leaderboard.UpdatePlayers([]types.PlayerInfo{
+		{Index: alice, EloRating: 1184},
+		{Index: bob, EloRating: 1216},
+	}, nil, leaderboardNow, 1)
+	require.Len(t, leaderboard.Winners, 1)
+	require.Equal(t, bob, leaderboard.Winners[0].PlayerAddress)
+}
+
+func TestLeaderboardUpdatePlayersDropsPlayersNoLongerBest(t *testing.T) {
+	leaderboard := types.Leaderboard{
+		Winners: []types.WinningPlayer{
+			{PlayerAddress: alice, EloRating: 1216, WonCount: 1, DateAdded: types.FormatDeadline(leaderboardEarlier)},
+			{PlayerAddress: bob, EloRating: 1184, WonCount: 0, DateAdded: types.FormatDeadline(leaderboardEarlier)},
+		},
+	}
+	leaderboard.UpdatePlayers([]types.PlayerInfo{
+		{Index: alice, EloRating: 1216, WonCount: 1},
+		{Index: testutil.Carol, EloRating: 1190, WonCount: 0},
+	}, map[string]bool{bob: true}, leaderboardNow, 2)
+	require.Equal(t, []types.WinningPlayer{
+		{PlayerAddress: alice, EloRating: 1216, WonCount: 1, DateAdded: types.FormatDeadline(leaderboardEarlier)},
+		{PlayerAddress: testutil.Carol, EloRating: 1190, WonCount: 0, DateAdded: types.FormatDeadline(leaderboardNow)},
+	}, leaderboard.Winners)
+}
+
+func TestLeaderboardValidate(t *testing.T) {
+	valid := types.WinningPlayer{PlayerAddress: alice, DateAdded: types.FormatDeadline(leaderboardNow)}
+	require.Nil(t, types.Leaderboard{Winners: []types.WinningPlayer{valid}}.Validate())
+	require.EqualError(t,
+		types.Leaderboard{Winners: []types.WinningPlayer{valid, valid}}.Validate(),
+		"duplicated player on the leaderboard: "+alice)
+	require.EqualError(t,
+		types.Leaderboard{Winners: []types.WinningPlayer{{PlayerAddress: alice, DateAdded: "yesterday"}}}.Validate(),
+		"date added cannot be parsed: yesterday: parsing time \"yesterday\" as \"2006-01-02 15:04:05.999999999 +0000 UTC\": cannot parse \"yesterday\" as \"2006\"")
+	require.Error(t, types.Leaderboard{Winners: []types.WinningPlayer{{PlayerAddress: "notanaddress", DateAdded: types.DeadlineLayout}}}.Validate())
+}
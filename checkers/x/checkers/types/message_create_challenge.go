@@ -0,0 +1,64 @@
+package types
+
+import (
+	"github.com/alice/checkers/rules"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateChallenge = "create_challenge"
+
+var _ sdk.Msg = &MsgCreateChallenge{}
+
+func NewMsgCreateChallenge(creator string, color string, wager uint64, variant string, denom string) *MsgCreateChallenge {
+	return &MsgCreateChallenge{
+		Creator: creator,
+		Color:   color,
+		Wager:   wager,
+		Variant: variant,
+		Denom:   denom,
+	}
+}
+
+func (msg *MsgCreateChallenge) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateChallenge) Type() string {
+	return TypeMsgCreateChallenge
+}
+
+func (msg *MsgCreateChallenge) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateChallenge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateChallenge) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Color != rules.PieceStrings[rules.BLACK_PLAYER] && msg.Color != rules.PieceStrings[rules.RED_PLAYER] {
+		return sdkerrors.Wrapf(ErrInvalidColor, "%s", msg.Color)
+	}
+	if _, found := rules.Variants[msg.Variant]; !found {
+		return sdkerrors.Wrapf(ErrUnknownVariant, "%s", msg.Variant)
+	}
+	if msg.Denom != "" {
+		if err := sdk.ValidateDenom(msg.Denom); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "%s", err.Error())
+		}
+	}
+	if err := ValidateWager(msg.Wager); err != nil {
+		return err
+	}
+	return nil
+}
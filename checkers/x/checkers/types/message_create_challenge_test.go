@@ -0,0 +1,73 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/alice/checkers/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateChallenge_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCreateChallenge
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCreateChallenge{
+				Creator: "invalid_address",
+				Color:   "b",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgCreateChallenge{
+				Creator: sample.AccAddress(),
+				Color:   "b",
+			},
+		}, {
+			name: "red seat",
+			msg: MsgCreateChallenge{
+				Creator: sample.AccAddress(),
+				Color:   "r",
+				Variant: "russian",
+			},
+		}, {
+			name: "invalid color",
+			msg: MsgCreateChallenge{
+				Creator: sample.AccAddress(),
+				Color:   "black",
+			},
+			err: ErrInvalidColor,
+		}, {
+			name: "unknown variant",
+			msg: MsgCreateChallenge{
+				Creator: sample.AccAddress(),
+				Color:   "b",
+				Variant: "chess",
+			},
+			err: ErrUnknownVariant,
+		}, {
+			name: "wager too large",
+			msg: MsgCreateChallenge{
+				Creator: sample.AccAddress(),
+				Color:   "b",
+				Wager:   math.MaxInt64 + 1,
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
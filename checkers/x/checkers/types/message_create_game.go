@@ -1,6 +1,7 @@
 package types
 
 import (
+	"github.com/alice/checkers/rules"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -9,12 +10,15 @@ const TypeMsgCreateGame = "create_game"
 
 var _ sdk.Msg = &MsgCreateGame{}
 
-func NewMsgCreateGame(creator string, black string, red string, wager uint64) *MsgCreateGame {
+func NewMsgCreateGame(creator string, black string, red string, wager sdk.Coin, variant string, fen string, timeControl TimeControl) *MsgCreateGame {
 	return &MsgCreateGame{
-		Creator: creator,
-		Black:   black,
-		Red:     red,
-		Wager:   wager,
+		Creator:     creator,
+		Black:       black,
+		Red:         red,
+		Wager:       wager,
+		Variant:     variant,
+		Fen:         fen,
+		TimeControl: timeControl,
 	}
 }
 
@@ -44,5 +48,25 @@ func (msg *MsgCreateGame) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
-	return nil
+	// The creator escrows their stake right away, so they have to take a seat.
+	if msg.Creator != msg.Black && msg.Creator != msg.Red {
+		return sdkerrors.Wrapf(ErrCreatorNotPlayer, "%s", msg.Creator)
+	}
+	variant, found := rules.Variants[msg.Variant]
+	if !found {
+		return sdkerrors.Wrapf(ErrUnknownVariant, "%s", msg.Variant)
+	}
+	if msg.Fen != "" {
+		if _, err := ParseStartFen(variant, msg.Fen); err != nil {
+			return err
+		}
+	}
+	if err := msg.Wager.Validate(); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "%s", err.Error())
+	}
+	if !msg.Wager.Amount.IsInt64() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "amount too large: %s", msg.Wager.Amount)
+	}
+	// The bounds are params, they are only checked when the game is created.
+	return msg.TimeControl.Validate()
 }
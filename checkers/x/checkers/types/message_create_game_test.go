@@ -1,14 +1,18 @@
 package types
 
 import (
+	"math"
 	"testing"
+	"time"
 
 	"github.com/alice/checkers/testutil/sample"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 )
 
 func TestMsgCreateGame_ValidateBasic(t *testing.T) {
+	creator := sample.AccAddress()
 	tests := []struct {
 		name string
 		msg  MsgCreateGame
@@ -18,13 +22,210 @@ func TestMsgCreateGame_ValidateBasic(t *testing.T) {
 			name: "invalid address",
 			msg: MsgCreateGame{
 				Creator: "invalid_address",
+				Wager:   sdk.NewInt64Coin("stake", 0),
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		}, {
 			name: "valid address",
 			msg: MsgCreateGame{
-				Creator: sample.AccAddress(),
+				Creator: creator,
+				Black:   creator,
+				Wager:   sdk.NewInt64Coin("stake", 0),
 			},
+		}, {
+			name: "creator plays red",
+			msg: MsgCreateGame{
+				Creator: creator,
+				Black:   sample.AccAddress(),
+				Red:     creator,
+				Wager:   sdk.NewInt64Coin("stake", 0),
+			},
+		}, {
+			name: "creator not a player",
+			msg: MsgCreateGame{
+				Creator: creator,
+				Black:   sample.AccAddress(),
+				Red:     sample.AccAddress(),
+				Wager:   sdk.NewInt64Coin("stake", 0),
+			},
+			err: ErrCreatorNotPlayer,
+		}, {
+			name: "known variant",
+			msg: MsgCreateGame{
+				Creator: creator,
+				Black:   creator,
+				Wager:   sdk.NewInt64Coin("stake", 0),
+				Variant: "international",
+			},
+		}, {
+			name: "unknown variant",
+			msg: MsgCreateGame{
+				Creator: creator,
+				Black:   creator,
+				Wager:   sdk.NewInt64Coin("stake", 0),
+				Variant: "chess",
+			},
+			err: ErrUnknownVariant,
+		}, {
+			name: "valid fen",
+			msg: MsgCreateGame{
+				Creator: creator,
+				Black:   creator,
+				Wager:   sdk.NewInt64Coin("stake", 0),
+				Fen:     "W:W21,K30:B5,9",
+			},
+		}, {
+			name: "valid fen with ranges",
+			msg: MsgCreateGame{
+				Creator: creator,
+				Black:   creator,
+				Wager:   sdk.NewInt64Coin("stake", 0),
+				Fen:     "B:W21-32:B1-12",
+			},
+		}, {
+			name: "unparsable fen",
+			msg: MsgCreateGame{
+				Creator: creator,
+				Black:   creator,
+				Wager:   sdk.NewInt64Coin("stake", 0),
+				Fen:     "W21,K30:B5,9",
+			},
+			err: ErrInvalidFen,
+		}, {
+			name: "fen square out of board",
+			msg: MsgCreateGame{
+				Creator: creator,
+				Black:   creator,
+				Wager:   sdk.NewInt64Coin("stake", 0),
+				Fen:     "W:W21,33:B5",
+			},
+			err: ErrInvalidFen,
+		}, {
+			name: "fen man not crowned",
+			msg: MsgCreateGame{
+				Creator: creator,
+				Black:   creator,
+				Wager:   sdk.NewInt64Coin("stake", 0),
+				Fen:     "W:W21:B29",
+			},
+			err: ErrInvalidFen,
+		}, {
+			name: "fen side without pieces",
+			msg: MsgCreateGame{
+				Creator: creator,
+				Black:   creator,
+				Wager:   sdk.NewInt64Coin("stake", 0),
+				Fen:     "W:W21:B",
+			},
+			err: ErrInvalidFen,
+		}, {
+			name: "fen too many pieces",
+			msg: MsgCreateGame{
+				Creator: creator,
+				Black:   creator,
+				Wager:   sdk.NewInt64Coin("stake", 0),
+				Fen:     "B:W20-32:B1-12",
+			},
+			err: ErrInvalidFen,
+		}, {
+			name: "fen side to move is blocked",
+			msg: MsgCreateGame{
+				Creator: creator,
+				Black:   creator,
+				Wager:   sdk.NewInt64Coin("stake", 0),
+				Fen:     "B:W8,11:B4",
+			},
+			err: ErrInvalidFen,
+		}, {
+			name: "fen of the variant board",
+			msg: MsgCreateGame{
+				Creator: creator,
+				Black:   creator,
+				Wager:   sdk.NewInt64Coin("stake", 0),
+				Variant: "international",
+				Fen:     "W:W46:B5",
+			},
+		}, {
+			name: "ibc denom",
+			msg: MsgCreateGame{
+				Creator: creator,
+				Black:   creator,
+				Wager:   sdk.NewInt64Coin("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", 0),
+			},
+		}, {
+			name: "invalid denom",
+			msg: MsgCreateGame{
+				Creator: creator,
+				Black:   creator,
+				Wager:   sdk.Coin{Denom: "1stake", Amount: sdk.ZeroInt()},
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "turn duration",
+			msg: MsgCreateGame{
+				Creator:     creator,
+				Black:       creator,
+				Wager:       sdk.NewInt64Coin("stake", 0),
+				TimeControl: TimeControl{TurnDuration: time.Minute},
+			},
+		}, {
+			name: "clock with increment",
+			msg: MsgCreateGame{
+				Creator:     creator,
+				Black:       creator,
+				Wager:       sdk.NewInt64Coin("stake", 0),
+				TimeControl: TimeControl{Clock: 10 * time.Minute, Increment: 5 * time.Second},
+			},
+		}, {
+			name: "negative turn duration",
+			msg: MsgCreateGame{
+				Creator:     creator,
+				Black:       creator,
+				Wager:       sdk.NewInt64Coin("stake", 0),
+				TimeControl: TimeControl{TurnDuration: -time.Minute},
+			},
+			err: ErrInvalidTimeControl,
+		}, {
+			name: "turn duration and clock",
+			msg: MsgCreateGame{
+				Creator:     creator,
+				Black:       creator,
+				Wager:       sdk.NewInt64Coin("stake", 0),
+				TimeControl: TimeControl{TurnDuration: time.Minute, Clock: 10 * time.Minute},
+			},
+			err: ErrInvalidTimeControl,
+		}, {
+			name: "increment without clock",
+			msg: MsgCreateGame{
+				Creator:     creator,
+				Black:       creator,
+				Wager:       sdk.NewInt64Coin("stake", 0),
+				TimeControl: TimeControl{TurnDuration: time.Minute, Increment: 5 * time.Second},
+			},
+			err: ErrInvalidTimeControl,
+		}, {
+			name: "no wager",
+			msg: MsgCreateGame{
+				Creator: creator,
+				Black:   creator,
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "negative wager",
+			msg: MsgCreateGame{
+				Creator: creator,
+				Black:   creator,
+				Wager:   sdk.Coin{Denom: "stake", Amount: sdk.NewInt(-1)},
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "wager too large",
+			msg: MsgCreateGame{
+				Creator: creator,
+				Black:   creator,
+				Wager:   sdk.NewCoin("stake", sdk.NewIntFromUint64(math.MaxInt64+1)),
+			},
+			err: sdkerrors.ErrInvalidCoins,
 		},
 	}
 	for _, tt := range tests {
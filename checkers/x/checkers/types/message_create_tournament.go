@@ -0,0 +1,69 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgCreateTournament = "create_tournament"
+
+var _ sdk.Msg = &MsgCreateTournament{}
+
+func NewMsgCreateTournament(creator string, format string, entryFee uint64, denom string, maxPlayers uint64, startTime string) *MsgCreateTournament {
+	return &MsgCreateTournament{
+		Creator:    creator,
+		Format:     format,
+		EntryFee:   entryFee,
+		Denom:      denom,
+		MaxPlayers: maxPlayers,
+		StartTime:  startTime,
+	}
+}
+
+func (msg *MsgCreateTournament) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateTournament) Type() string {
+	return TypeMsgCreateTournament
+}
+
+func (msg *MsgCreateTournament) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateTournament) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateTournament) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Format != TournamentFormatSingleElimination && msg.Format != TournamentFormatRoundRobin {
+		return sdkerrors.Wrapf(ErrUnknownTournamentFormat, "%s", msg.Format)
+	}
+	if msg.MaxPlayers < 2 || MaxTournamentPlayers < msg.MaxPlayers {
+		return sdkerrors.Wrapf(ErrInvalidMaxPlayers, "%d", msg.MaxPlayers)
+	}
+	if msg.Denom != "" {
+		if err := sdk.ValidateDenom(msg.Denom); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "%s", err.Error())
+		}
+	}
+	if err := ValidateWager(msg.EntryFee); err != nil {
+		return err
+	}
+	if _, err := time.Parse(DeadlineLayout, msg.StartTime); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidStartTime, "%s", msg.StartTime)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"math"
+	"testing"
+
+	"github.com/alice/checkers/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateTournament_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCreateTournament
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCreateTournament{
+				Creator:    "invalid_address",
+				Format:     TournamentFormatSingleElimination,
+				MaxPlayers: 8,
+				StartTime:  "2006-01-02 15:04:05.999999999 +0000 UTC",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				Format:     TournamentFormatSingleElimination,
+				MaxPlayers: 8,
+				StartTime:  "2006-01-02 15:04:05.999999999 +0000 UTC",
+			},
+		}, {
+			name: "round robin",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				Format:     TournamentFormatRoundRobin,
+				EntryFee:   10,
+				Denom:      "coin",
+				MaxPlayers: MaxTournamentPlayers,
+				StartTime:  "2006-01-02 15:04:05.999999999 +0000 UTC",
+			},
+		}, {
+			name: "unknown format",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				Format:     "swiss",
+				MaxPlayers: 8,
+				StartTime:  "2006-01-02 15:04:05.999999999 +0000 UTC",
+			},
+			err: ErrUnknownTournamentFormat,
+		}, {
+			name: "single player",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				Format:     TournamentFormatRoundRobin,
+				MaxPlayers: 1,
+				StartTime:  "2006-01-02 15:04:05.999999999 +0000 UTC",
+			},
+			err: ErrInvalidMaxPlayers,
+		}, {
+			name: "too many players",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				Format:     TournamentFormatRoundRobin,
+				MaxPlayers: MaxTournamentPlayers + 1,
+				StartTime:  "2006-01-02 15:04:05.999999999 +0000 UTC",
+			},
+			err: ErrInvalidMaxPlayers,
+		}, {
+			name: "invalid denom",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				Format:     TournamentFormatRoundRobin,
+				Denom:      "1",
+				MaxPlayers: 8,
+				StartTime:  "2006-01-02 15:04:05.999999999 +0000 UTC",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "invalid start time",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				Format:     TournamentFormatRoundRobin,
+				MaxPlayers: 8,
+				StartTime:  "tomorrow",
+			},
+			err: ErrInvalidStartTime,
+		}, {
+			name: "entry fee too large",
+			msg: MsgCreateTournament{
+				Creator:    sample.AccAddress(),
+				Format:     TournamentFormatRoundRobin,
+				MaxPlayers: 8,
+				EntryFee:   math.MaxInt64 + 1,
+				StartTime:  "2006-01-02 15:04:05.999999999 +0000 UTC",
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgOfferRematch = "offer_rematch"
+
+var _ sdk.Msg = &MsgOfferRematch{}
+
+func NewMsgOfferRematch(creator string, gameIndex string) *MsgOfferRematch {
+	return &MsgOfferRematch{
+		Creator:   creator,
+		GameIndex: gameIndex,
+	}
+}
+
+func (msg *MsgOfferRematch) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgOfferRematch) Type() string {
+	return TypeMsgOfferRematch
+}
+
+func (msg *MsgOfferRematch) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgOfferRematch) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgOfferRematch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgPlayMoves = "play_moves"
+
+var _ sdk.Msg = &MsgPlayMoves{}
+
+func NewMsgPlayMoves(creator string, gameIndex string, path []Position) *MsgPlayMoves {
+	return &MsgPlayMoves{
+		Creator:   creator,
+		GameIndex: gameIndex,
+		Path:      path,
+	}
+}
+
+func (msg *MsgPlayMoves) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPlayMoves) Type() string {
+	return TypeMsgPlayMoves
+}
+
+func (msg *MsgPlayMoves) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPlayMoves) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPlayMoves) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if len(msg.Path) < 2 {
+		return sdkerrors.Wrapf(ErrPathTooShort, "%d", len(msg.Path))
+	}
+	return nil
+}
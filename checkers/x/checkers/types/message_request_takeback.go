@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const TypeMsgRequestTakeback = "request_takeback"
+
+var _ sdk.Msg = &MsgRequestTakeback{}
+
+func NewMsgRequestTakeback(creator string, gameIndex string, plies uint64) *MsgRequestTakeback {
+	return &MsgRequestTakeback{
+		Creator:   creator,
+		GameIndex: gameIndex,
+		Plies:     plies,
+	}
+}
+
+func (msg *MsgRequestTakeback) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRequestTakeback) Type() string {
+	return TypeMsgRequestTakeback
+}
+
+func (msg *MsgRequestTakeback) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRequestTakeback) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRequestTakeback) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if msg.Plies == 0 {
+		return ErrInvalidPlies
+	}
+	return nil
+}
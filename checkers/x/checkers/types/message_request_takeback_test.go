@@ -0,0 +1,47 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/testutil/sample"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRequestTakeback_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgRequestTakeback
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRequestTakeback{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "no plies",
+			msg: MsgRequestTakeback{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrInvalidPlies,
+		}, {
+			name: "valid address",
+			msg: MsgRequestTakeback{
+				Creator: sample.AccAddress(),
+				Plies:   1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
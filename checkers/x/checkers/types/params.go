@@ -1,39 +1,347 @@
 package types
 
 import (
+	"fmt"
+	"math"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyAllowedDenoms = []byte("AllowedDenoms")
+	// Only the staking denomination is accepted until governance adds others, such as IBC vouchers.
+	DefaultAllowedDenoms = []string{sdk.DefaultBondDenom}
+)
+
+var (
+	KeyMaxTurnDuration     = []byte("MaxTurnDuration")
+	DefaultMaxTurnDuration = time.Duration(5 * 60 * 1000_000_000) // 5 minutes
+)
+
+var (
+	KeyMinTimeControl     = []byte("MinTimeControl")
+	KeyMaxTimeControl     = []byte("MaxTimeControl")
+	DefaultMinTimeControl = time.Duration(30 * 1000_000_000)             // 30 seconds
+	DefaultMaxTimeControl = time.Duration(7 * 24 * 3_600 * 1000_000_000) // 1 week
+)
+
+var (
+	KeyCreateGameGas           = []byte("CreateGameGas")
+	KeyPlayMoveGas             = []byte("PlayMoveGas")
+	KeyRejectGameRefundGas     = []byte("RejectGameRefundGas")
+	DefaultCreateGameGas       = uint64(15000)
+	DefaultPlayMoveGas         = uint64(1000)
+	DefaultRejectGameRefundGas = uint64(14000)
+)
+
+var (
+	KeyLeaderboardLength              = []byte("LeaderboardLength")
+	KeyLeaderboardRefreshInterval     = []byte("LeaderboardRefreshInterval")
+	DefaultLeaderboardLength          = uint64(100)
+	DefaultLeaderboardRefreshInterval = uint64(100) // blocks
+)
+
+var (
+	KeyMaxTakebacks     = []byte("MaxTakebacks")
+	DefaultMaxTakebacks = uint64(3)
+)
+
+var (
+	KeyMaxMovesWithoutProgress     = []byte("MaxMovesWithoutProgress")
+	DefaultMaxMovesWithoutProgress = uint64(40)
+)
+
+var (
+	KeyMaxChallengeDuration     = []byte("MaxChallengeDuration")
+	DefaultMaxChallengeDuration = time.Duration(24 * 3_600 * 1000_000_000) // 1 day
+)
+
+var (
+	KeyMaxForfeitsPerBlock              = []byte("MaxForfeitsPerBlock")
+	KeyMaxChallengeExpiriesPerBlock     = []byte("MaxChallengeExpiriesPerBlock")
+	DefaultMaxForfeitsPerBlock          = uint64(100)
+	DefaultMaxChallengeExpiriesPerBlock = uint64(100)
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	allowedDenoms []string,
+	maxTurnDuration time.Duration,
+	createGameGas uint64,
+	playMoveGas uint64,
+	rejectGameRefundGas uint64,
+	minTimeControl time.Duration,
+	maxTimeControl time.Duration,
+	leaderboardLength uint64,
+	leaderboardRefreshInterval uint64,
+	maxTakebacks uint64,
+	maxMovesWithoutProgress uint64,
+	maxChallengeDuration time.Duration,
+	maxForfeitsPerBlock uint64,
+	maxChallengeExpiriesPerBlock uint64,
+) Params {
+	return Params{
+		AllowedDenoms:                allowedDenoms,
+		MaxTurnDuration:              maxTurnDuration,
+		CreateGameGas:                createGameGas,
+		PlayMoveGas:                  playMoveGas,
+		RejectGameRefundGas:          rejectGameRefundGas,
+		MinTimeControl:               minTimeControl,
+		MaxTimeControl:               maxTimeControl,
+		LeaderboardLength:            leaderboardLength,
+		LeaderboardRefreshInterval:   leaderboardRefreshInterval,
+		MaxTakebacks:                 maxTakebacks,
+		MaxMovesWithoutProgress:      maxMovesWithoutProgress,
+		MaxChallengeDuration:         maxChallengeDuration,
+		MaxForfeitsPerBlock:          maxForfeitsPerBlock,
+		MaxChallengeExpiriesPerBlock: maxChallengeExpiriesPerBlock,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultAllowedDenoms,
+		DefaultMaxTurnDuration,
+		DefaultCreateGameGas,
+		DefaultPlayMoveGas,
+		DefaultRejectGameRefundGas,
+		DefaultMinTimeControl,
+		DefaultMaxTimeControl,
+		DefaultLeaderboardLength,
+		DefaultLeaderboardRefreshInterval,
+		DefaultMaxTakebacks,
+		DefaultMaxMovesWithoutProgress,
+		DefaultMaxChallengeDuration,
+		DefaultMaxForfeitsPerBlock,
+		DefaultMaxChallengeExpiriesPerBlock,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyAllowedDenoms, &p.AllowedDenoms, validateAllowedDenoms),
+		paramtypes.NewParamSetPair(KeyMaxTurnDuration, &p.MaxTurnDuration, validateMaxTurnDuration),
+		paramtypes.NewParamSetPair(KeyCreateGameGas, &p.CreateGameGas, validateGas),
+		paramtypes.NewParamSetPair(KeyPlayMoveGas, &p.PlayMoveGas, validateGas),
+		paramtypes.NewParamSetPair(KeyRejectGameRefundGas, &p.RejectGameRefundGas, validateGas),
+		paramtypes.NewParamSetPair(KeyMinTimeControl, &p.MinTimeControl, validateTimeControlBound),
+		paramtypes.NewParamSetPair(KeyMaxTimeControl, &p.MaxTimeControl, validateTimeControlBound),
+		paramtypes.NewParamSetPair(KeyLeaderboardLength, &p.LeaderboardLength, validateLeaderboardLength),
+		paramtypes.NewParamSetPair(KeyLeaderboardRefreshInterval, &p.LeaderboardRefreshInterval, validateLeaderboardRefreshInterval),
+		paramtypes.NewParamSetPair(KeyMaxTakebacks, &p.MaxTakebacks, validateMaxTakebacks),
+		paramtypes.NewParamSetPair(KeyMaxMovesWithoutProgress, &p.MaxMovesWithoutProgress, validateMaxMovesWithoutProgress),
+		paramtypes.NewParamSetPair(KeyMaxChallengeDuration, &p.MaxChallengeDuration, validateMaxChallengeDuration),
+		paramtypes.NewParamSetPair(KeyMaxForfeitsPerBlock, &p.MaxForfeitsPerBlock, validatePerBlockLimit),
+		paramtypes.NewParamSetPair(KeyMaxChallengeExpiriesPerBlock, &p.MaxChallengeExpiriesPerBlock, validatePerBlockLimit),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateAllowedDenoms(p.AllowedDenoms); err != nil {
+		return err
+	}
+	if err := validateMaxTurnDuration(p.MaxTurnDuration); err != nil {
+		return err
+	}
+	if err := validateGas(p.CreateGameGas); err != nil {
+		return err
+	}
+	if err := validateGas(p.PlayMoveGas); err != nil {
+		return err
+	}
+	if err := validateGas(p.RejectGameRefundGas); err != nil {
+		return err
+	}
+	if err := validateTimeControlBound(p.MinTimeControl); err != nil {
+		return err
+	}
+	if err := validateTimeControlBound(p.MaxTimeControl); err != nil {
+		return err
+	}
+	if err := validateLeaderboardLength(p.LeaderboardLength); err != nil {
+		return err
+	}
+	if err := validateLeaderboardRefreshInterval(p.LeaderboardRefreshInterval); err != nil {
+		return err
+	}
+	if err := validateMaxTakebacks(p.MaxTakebacks); err != nil {
+		return err
+	}
+	if err := validateMaxMovesWithoutProgress(p.MaxMovesWithoutProgress); err != nil {
+		return err
+	}
+	if err := validateMaxChallengeDuration(p.MaxChallengeDuration); err != nil {
+		return err
+	}
+	if err := validatePerBlockLimit(p.MaxForfeitsPerBlock); err != nil {
+		return err
+	}
+	if err := validatePerBlockLimit(p.MaxChallengeExpiriesPerBlock); err != nil {
+		return err
+	}
+	return p.ValidateTimeControls()
+}
+
+// ValidateTimeControls checks the time params against one another. A param change proposal only
+// validates each changed param on its own, so the keeper checks them again before using them.
+func (p Params) ValidateTimeControls() error {
+	if p.MaxTimeControl < p.MinTimeControl {
+		return sdkerrors.Wrapf(ErrInconsistentTimeParams, "min time control %s above max time control %s",
+			p.MinTimeControl, p.MaxTimeControl)
+	}
+	// Games that do not choose a time control get the max turn duration, so it has to be within bounds.
+	if p.MaxTurnDuration < p.MinTimeControl || p.MaxTimeControl < p.MaxTurnDuration {
+		return sdkerrors.Wrapf(ErrInconsistentTimeParams, "max turn duration %s not within time control bounds %s and %s",
+			p.MaxTurnDuration, p.MinTimeControl, p.MaxTimeControl)
+	}
 	return nil
 }
 
+// IsAllowedDenom tells whether games can be wagered in the denomination.
+func (p Params) IsAllowedDenom(denom string) bool {
+	for _, allowed := range p.AllowedDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateAllowedDenoms(i interface{}) error {
+	denoms, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate allowed denom: %s", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
+func validateMaxTurnDuration(i interface{}) error {
+	duration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if duration <= 0 {
+		return fmt.Errorf("max turn duration must be positive: %s", duration)
+	}
+	return nil
+}
+
+// Any amount of gas is acceptable, including none.
+func validateGas(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateTimeControlBound(i interface{}) error {
+	duration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if duration <= 0 {
+		return fmt.Errorf("time control bound must be positive: %s", duration)
+	}
+	return nil
+}
+
+func validateLeaderboardLength(i interface{}) error {
+	length, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if length == 0 {
+		return fmt.Errorf("leaderboard length must be positive: %d", length)
+	}
+	return nil
+}
+
+func validateLeaderboardRefreshInterval(i interface{}) error {
+	interval, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if interval == 0 {
+		return fmt.Errorf("leaderboard refresh interval must be positive: %d", interval)
+	}
+	return nil
+}
+
+// Any number of takebacks is acceptable, none disabling them.
+func validateMaxTakebacks(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+// Games go on after a capture or a man moving, so kings alone need a limit on how long they can go.
+func validateMaxMovesWithoutProgress(i interface{}) error {
+	moves, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if moves == 0 {
+		return fmt.Errorf("max moves without progress must be positive: %d", moves)
+	}
+	if math.MaxInt32 < moves {
+		return fmt.Errorf("max moves without progress too large: %d", moves)
+	}
+	return nil
+}
+
+func validateMaxChallengeDuration(i interface{}) error {
+	duration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if duration <= 0 {
+		return fmt.Errorf("max challenge duration must be positive: %s", duration)
+	}
+	return nil
+}
+
+// Without any, nothing would ever expire.
+func validatePerBlockLimit(i interface{}) error {
+	limit, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if limit == 0 {
+		return fmt.Errorf("per block limit must be positive: %d", limit)
+	}
+	if math.MaxInt32 < limit {
+		return fmt.Errorf("per block limit too large: %d", limit)
+	}
+	return nil
+}
@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -25,6 +29,34 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// The denominations games can be wagered in.
+	AllowedDenoms []string `protobuf:"bytes,1,rep,name=allowedDenoms,proto3" json:"allowedDenoms,omitempty" yaml:"allowed_denoms"`
+	// How long a player has to make their move before they forfeit the game.
+	MaxTurnDuration time.Duration `protobuf:"bytes,2,opt,name=maxTurnDuration,proto3,stdduration" json:"maxTurnDuration" yaml:"max_turn_duration"`
+	// The gas charged on top of the store accesses when creating a game.
+	CreateGameGas uint64 `protobuf:"varint,3,opt,name=createGameGas,proto3" json:"createGameGas,omitempty" yaml:"create_game_gas"`
+	// The gas charged for each hop of a move.
+	PlayMoveGas uint64 `protobuf:"varint,4,opt,name=playMoveGas,proto3" json:"playMoveGas,omitempty" yaml:"play_move_gas"`
+	// The most gas given back to a player who rejects a game.
+	RejectGameRefundGas uint64 `protobuf:"varint,5,opt,name=rejectGameRefundGas,proto3" json:"rejectGameRefundGas,omitempty" yaml:"reject_game_refund_gas"`
+	// The shortest turn duration or clock a game can be created with.
+	MinTimeControl time.Duration `protobuf:"bytes,6,opt,name=minTimeControl,proto3,stdduration" json:"minTimeControl" yaml:"min_time_control"`
+	// The longest turn duration, clock or increment a game can be created with.
+	MaxTimeControl time.Duration `protobuf:"bytes,7,opt,name=maxTimeControl,proto3,stdduration" json:"maxTimeControl" yaml:"max_time_control"`
+	// How many players the leaderboard keeps.
+	LeaderboardLength uint64 `protobuf:"varint,8,opt,name=leaderboardLength,proto3" json:"leaderboardLength,omitempty" yaml:"leaderboard_length"`
+	// How many blocks go by between refreshes of the leaderboard.
+	LeaderboardRefreshInterval uint64 `protobuf:"varint,9,opt,name=leaderboardRefreshInterval,proto3" json:"leaderboardRefreshInterval,omitempty" yaml:"leaderboard_refresh_interval"`
+	// How many takebacks can be agreed on in a game, none when zero.
+	MaxTakebacks uint64 `protobuf:"varint,10,opt,name=maxTakebacks,proto3" json:"maxTakebacks,omitempty" yaml:"max_takebacks"`
+	// How many moves each side can play without a capture or a man moving before the game is drawn.
+	MaxMovesWithoutProgress uint64 `protobuf:"varint,11,opt,name=maxMovesWithoutProgress,proto3" json:"maxMovesWithoutProgress,omitempty" yaml:"max_moves_without_progress"`
+	// How long a challenge stays open for someone to accept it.
+	MaxChallengeDuration time.Duration `protobuf:"bytes,12,opt,name=maxChallengeDuration,proto3,stdduration" json:"maxChallengeDuration" yaml:"max_challenge_duration"`
+	// How many games can be forfeited in a block, the others waiting for the next one.
+	MaxForfeitsPerBlock uint64 `protobuf:"varint,13,opt,name=maxForfeitsPerBlock,proto3" json:"maxForfeitsPerBlock,omitempty" yaml:"max_forfeits_per_block"`
+	// How many challenges can expire in a block, the others waiting for the next one.
+	MaxChallengeExpiriesPerBlock uint64 `protobuf:"varint,14,opt,name=maxChallengeExpiriesPerBlock,proto3" json:"maxChallengeExpiriesPerBlock,omitempty" yaml:"max_challenge_expiries_per_block"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -59,6 +91,104 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMaxTurnDuration() time.Duration {
+	if m != nil {
+		return m.MaxTurnDuration
+	}
+	return 0
+}
+
+func (m *Params) GetCreateGameGas() uint64 {
+	if m != nil {
+		return m.CreateGameGas
+	}
+	return 0
+}
+
+func (m *Params) GetPlayMoveGas() uint64 {
+	if m != nil {
+		return m.PlayMoveGas
+	}
+	return 0
+}
+
+func (m *Params) GetRejectGameRefundGas() uint64 {
+	if m != nil {
+		return m.RejectGameRefundGas
+	}
+	return 0
+}
+
+func (m *Params) GetMinTimeControl() time.Duration {
+	if m != nil {
+		return m.MinTimeControl
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTimeControl() time.Duration {
+	if m != nil {
+		return m.MaxTimeControl
+	}
+	return 0
+}
+
+func (m *Params) GetLeaderboardLength() uint64 {
+	if m != nil {
+		return m.LeaderboardLength
+	}
+	return 0
+}
+
+func (m *Params) GetLeaderboardRefreshInterval() uint64 {
+	if m != nil {
+		return m.LeaderboardRefreshInterval
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTakebacks() uint64 {
+	if m != nil {
+		return m.MaxTakebacks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMovesWithoutProgress() uint64 {
+	if m != nil {
+		return m.MaxMovesWithoutProgress
+	}
+	return 0
+}
+
+func (m *Params) GetMaxChallengeDuration() time.Duration {
+	if m != nil {
+		return m.MaxChallengeDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMaxForfeitsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxForfeitsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxChallengeExpiriesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxChallengeExpiriesPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "alice.checkers.checkers.Params")
 }
@@ -66,16 +196,50 @@ func init() {
 func init() { proto.RegisterFile("checkers/params.proto", fileDescriptor_ec14988318ba9aaa) }
 
 var fileDescriptor_ec14988318ba9aaa = []byte{
-	// 143 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4d, 0xce, 0x48, 0x4d,
-	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x12, 0x4f, 0xcc, 0xc9, 0x4c, 0x4e, 0xd5, 0x83, 0x49, 0xc2, 0x19, 0x52, 0x22, 0xe9,
-	0xf9, 0xe9, 0xf9, 0x60, 0x35, 0xfa, 0x20, 0x16, 0x44, 0xb9, 0x12, 0x1f, 0x17, 0x5b, 0x00, 0x58,
-	0xbb, 0x15, 0xcb, 0x8c, 0x05, 0xf2, 0x0c, 0x4e, 0x2e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24,
-	0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78,
-	0x2c, 0xc7, 0x10, 0xa5, 0x95, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x0f,
-	0xb6, 0x43, 0x1f, 0xee, 0x80, 0x0a, 0x04, 0xb3, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x6c,
-	0xb8, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xd7, 0x26, 0xe7, 0x98, 0xa4, 0x00, 0x00, 0x00,
+	// 677 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x5d, 0x4f, 0xd4, 0x4e,
+	0x14, 0xc6, 0x77, 0xff, 0xf0, 0x47, 0x19, 0x5e, 0x8c, 0x15, 0xa4, 0x6c, 0xa4, 0x85, 0xa2, 0x01,
+	0x35, 0xd9, 0x4d, 0xf4, 0x8e, 0x98, 0x68, 0x16, 0x94, 0x18, 0x35, 0x21, 0x95, 0xc4, 0xc4, 0x9b,
+	0xc9, 0x6c, 0xf7, 0x6c, 0xb7, 0x6e, 0xa7, 0xd3, 0x4c, 0xa7, 0x50, 0xbe, 0x85, 0x97, 0x5c, 0xfa,
+	0x71, 0xb8, 0xe4, 0xd2, 0xab, 0x6a, 0xe0, 0x1b, 0xf4, 0x13, 0x98, 0x99, 0xe9, 0xb2, 0x2f, 0xac,
+	0x10, 0x6f, 0x36, 0xb3, 0x3d, 0xcf, 0xf3, 0x7b, 0x4e, 0x4f, 0x4f, 0x8b, 0x96, 0xbd, 0x2e, 0x78,
+	0x3d, 0xe0, 0x49, 0x23, 0x26, 0x9c, 0xd0, 0xa4, 0x1e, 0x73, 0x26, 0x98, 0xb1, 0x42, 0xc2, 0xc0,
+	0x83, 0x7a, 0xbf, 0x78, 0x75, 0xa8, 0x2d, 0xf9, 0xcc, 0x67, 0x4a, 0xd3, 0x90, 0x27, 0x2d, 0xaf,
+	0x59, 0x3e, 0x63, 0x7e, 0x08, 0x0d, 0xf5, 0xaf, 0x95, 0x76, 0x1a, 0xed, 0x94, 0x13, 0x11, 0xb0,
+	0x48, 0xd7, 0x9d, 0x62, 0x16, 0xcd, 0x1c, 0x28, 0xbe, 0xf1, 0x1a, 0x2d, 0x90, 0x30, 0x64, 0xc7,
+	0xd0, 0xde, 0x83, 0x88, 0xd1, 0xc4, 0xac, 0xae, 0x4f, 0x6d, 0xcf, 0x36, 0x57, 0x8b, 0xdc, 0x5e,
+	0x3e, 0x21, 0x34, 0xdc, 0x71, 0xca, 0x32, 0x6e, 0xab, 0xba, 0xe3, 0x8e, 0xea, 0x8d, 0x00, 0xdd,
+	0xa3, 0x24, 0x3b, 0x4c, 0x79, 0xb4, 0x57, 0x86, 0x98, 0xff, 0xad, 0x57, 0xb7, 0xe7, 0x5e, 0xac,
+	0xd6, 0x75, 0x17, 0xf5, 0x7e, 0x17, 0xf5, 0xbe, 0xa0, 0xf9, 0xf8, 0x2c, 0xb7, 0x2b, 0x45, 0x6e,
+	0x9b, 0x3a, 0x81, 0x92, 0x0c, 0x8b, 0x94, 0x47, 0xb8, 0xdf, 0xa6, 0x73, 0xfa, 0xcb, 0xae, 0xba,
+	0xe3, 0x5c, 0xe3, 0x0d, 0x5a, 0xf0, 0x38, 0x10, 0x01, 0xfb, 0x84, 0xc2, 0x3e, 0x49, 0xcc, 0xa9,
+	0xf5, 0xea, 0xf6, 0x74, 0xb3, 0x56, 0xe4, 0xf6, 0x43, 0x4d, 0xd2, 0x65, 0xec, 0x13, 0x2a, 0x7f,
+	0x64, 0xb3, 0x23, 0x06, 0x63, 0x07, 0xcd, 0xc5, 0x21, 0x39, 0xf9, 0xc4, 0x8e, 0x94, 0x7f, 0x5a,
+	0xf9, 0xcd, 0x22, 0xb7, 0x97, 0xb4, 0x5f, 0x16, 0x31, 0x65, 0x47, 0xa5, 0x7b, 0x58, 0x6c, 0x7c,
+	0x46, 0x0f, 0x38, 0x7c, 0x03, 0x4f, 0x48, 0x98, 0x0b, 0x9d, 0x34, 0x6a, 0x4b, 0xc6, 0xff, 0x8a,
+	0xb1, 0x51, 0xe4, 0xf6, 0x9a, 0x66, 0x68, 0x91, 0xee, 0x81, 0x2b, 0x99, 0x86, 0x4d, 0x72, 0x1b,
+	0x1d, 0xb4, 0x48, 0x83, 0xe8, 0x30, 0xa0, 0xb0, 0xcb, 0x22, 0xc1, 0x59, 0x68, 0xce, 0xdc, 0x36,
+	0xbc, 0xcd, 0x72, 0x78, 0x2b, 0xe5, 0xf0, 0x82, 0x08, 0x8b, 0x80, 0x02, 0xf6, 0x34, 0x40, 0xcf,
+	0x6e, 0x8c, 0xaa, 0x72, 0x48, 0x36, 0x9c, 0x73, 0xe7, 0x5f, 0x73, 0x48, 0x36, 0x31, 0x67, 0x84,
+	0x6a, 0x7c, 0x40, 0xf7, 0x43, 0x20, 0x6d, 0xe0, 0x2d, 0x46, 0x78, 0xfb, 0x23, 0x44, 0xbe, 0xe8,
+	0x9a, 0x77, 0xd5, 0x88, 0xd6, 0x8a, 0xdc, 0x5e, 0xd5, 0xac, 0x21, 0x09, 0x0e, 0x95, 0xc6, 0x71,
+	0xaf, 0xfb, 0x0c, 0x1f, 0xd5, 0x86, 0x2e, 0xba, 0xd0, 0xe1, 0x90, 0x74, 0xdf, 0x47, 0x02, 0xf8,
+	0x11, 0x09, 0xcd, 0x59, 0x45, 0xdd, 0x2a, 0x72, 0x7b, 0xf3, 0x3a, 0x95, 0x6b, 0x31, 0x0e, 0x4a,
+	0xb5, 0xe3, 0xde, 0x80, 0x32, 0x5e, 0xa1, 0x79, 0x79, 0x1f, 0xa4, 0x07, 0x2d, 0xe2, 0xf5, 0x12,
+	0x13, 0x8d, 0xef, 0x85, 0xba, 0xf9, 0x7e, 0xd9, 0x71, 0x47, 0xd4, 0x06, 0x46, 0x2b, 0x94, 0x64,
+	0x72, 0x4d, 0x92, 0x2f, 0x81, 0xe8, 0xb2, 0x54, 0x1c, 0x70, 0xe6, 0x73, 0x48, 0x12, 0x73, 0x4e,
+	0x81, 0x9e, 0x14, 0xb9, 0xbd, 0x31, 0x00, 0xc9, 0xfd, 0x4a, 0xf0, 0xb1, 0x96, 0xe2, 0xb8, 0xd4,
+	0x3a, 0xee, 0xdf, 0x28, 0x46, 0x86, 0x96, 0x28, 0xc9, 0x76, 0xbb, 0x24, 0x94, 0xc3, 0x82, 0xab,
+	0xf7, 0x6c, 0xfe, 0xb6, 0x47, 0xf8, 0xb4, 0x7c, 0x84, 0x6b, 0x83, 0x70, 0xaf, 0x4f, 0x19, 0x7b,
+	0xd9, 0x26, 0x26, 0xc8, 0x9d, 0xa7, 0x24, 0x7b, 0xc7, 0x78, 0x07, 0x02, 0x91, 0x1c, 0x00, 0x6f,
+	0x86, 0xcc, 0xeb, 0x99, 0x0b, 0xe3, 0x3b, 0x2f, 0xc9, 0x9d, 0x52, 0x85, 0x63, 0xe0, 0xb8, 0x25,
+	0x75, 0x8e, 0x3b, 0xc9, 0x6d, 0x30, 0xf4, 0x68, 0x38, 0xec, 0x6d, 0x16, 0x07, 0x3c, 0x80, 0x01,
+	0x7d, 0x51, 0xd1, 0x9f, 0x17, 0xb9, 0xbd, 0x35, 0xa9, 0x6f, 0x28, 0xf5, 0xc3, 0x39, 0x37, 0x02,
+	0x77, 0xa6, 0x4f, 0x7f, 0xd8, 0x95, 0xe6, 0xde, 0xd9, 0x85, 0x55, 0x3d, 0xbf, 0xb0, 0xaa, 0xbf,
+	0x2f, 0xac, 0xea, 0xf7, 0x4b, 0xab, 0x72, 0x7e, 0x69, 0x55, 0x7e, 0x5e, 0x5a, 0x95, 0xaf, 0xcf,
+	0xfc, 0x40, 0x74, 0xd3, 0x56, 0xdd, 0x63, 0xb4, 0xa1, 0x3e, 0xb4, 0x8d, 0xab, 0xaf, 0x70, 0x36,
+	0x38, 0x8a, 0x93, 0x18, 0x92, 0xd6, 0x8c, 0x9a, 0xf2, 0xcb, 0x3f, 0x03, 0x00, 0x2d, 0xa6, 0x1c,
+	0x2c, 0xa9, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -98,6 +262,92 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxChallengeExpiriesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxChallengeExpiriesPerBlock))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxForfeitsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxForfeitsPerBlock))
+		i--
+		dAtA[i] = 0x68
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxChallengeDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxChallengeDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x62
+	if m.MaxMovesWithoutProgress != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMovesWithoutProgress))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxTakebacks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTakebacks))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.LeaderboardRefreshInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LeaderboardRefreshInterval))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LeaderboardLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LeaderboardLength))
+		i--
+		dAtA[i] = 0x40
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxTimeControl, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxTimeControl):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinTimeControl, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinTimeControl):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x32
+	if m.RejectGameRefundGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RejectGameRefundGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PlayMoveGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PlayMoveGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CreateGameGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CreateGameGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxTurnDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxTurnDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -118,6 +368,47 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxTurnDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.CreateGameGas != 0 {
+		n += 1 + sovParams(uint64(m.CreateGameGas))
+	}
+	if m.PlayMoveGas != 0 {
+		n += 1 + sovParams(uint64(m.PlayMoveGas))
+	}
+	if m.RejectGameRefundGas != 0 {
+		n += 1 + sovParams(uint64(m.RejectGameRefundGas))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinTimeControl)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxTimeControl)
+	n += 1 + l + sovParams(uint64(l))
+	if m.LeaderboardLength != 0 {
+		n += 1 + sovParams(uint64(m.LeaderboardLength))
+	}
+	if m.LeaderboardRefreshInterval != 0 {
+		n += 1 + sovParams(uint64(m.LeaderboardRefreshInterval))
+	}
+	if m.MaxTakebacks != 0 {
+		n += 1 + sovParams(uint64(m.MaxTakebacks))
+	}
+	if m.MaxMovesWithoutProgress != 0 {
+		n += 1 + sovParams(uint64(m.MaxMovesWithoutProgress))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxChallengeDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxForfeitsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxForfeitsPerBlock))
+	}
+	if m.MaxChallengeExpiriesPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxChallengeExpiriesPerBlock))
+	}
 	return n
 }
 
@@ -156,6 +447,341 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTurnDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxTurnDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateGameGas", wireType)
+			}
+			m.CreateGameGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateGameGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlayMoveGas", wireType)
+			}
+			m.PlayMoveGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlayMoveGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectGameRefundGas", wireType)
+			}
+			m.RejectGameRefundGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RejectGameRefundGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTimeControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinTimeControl, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimeControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxTimeControl, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderboardLength", wireType)
+			}
+			m.LeaderboardLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaderboardLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaderboardRefreshInterval", wireType)
+			}
+			m.LeaderboardRefreshInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeaderboardRefreshInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTakebacks", wireType)
+			}
+			m.MaxTakebacks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTakebacks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMovesWithoutProgress", wireType)
+			}
+			m.MaxMovesWithoutProgress = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMovesWithoutProgress |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChallengeDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxChallengeDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxForfeitsPerBlock", wireType)
+			}
+			m.MaxForfeitsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxForfeitsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChallengeExpiriesPerBlock", wireType)
+			}
+			m.MaxChallengeExpiriesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxChallengeExpiriesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// Players start with this rating, and a game moves it by at most the K-factor.
+	DefaultEloRating = 1200
+	EloKFactor       = 32
+	// As with FIDE, a larger rating difference counts as this one.
+	EloMaxDifference = 400
+)
+
+// eloStep is 10^(1/400), by which the odds of winning grow with each rating point ahead.
+// The computation is kept in decimals so that all nodes agree on the result.
+var eloStep = sdk.MustNewDecFromStr("1.005773063")
+
+// NewPlayerInfo returns the record of a player who has not finished a game yet.
+func NewPlayerInfo(address string) PlayerInfo {
+	return PlayerInfo{
+		Index:     address,
+		EloRating: DefaultEloRating,
+	}
+}
+
+// EloExpectedScore returns the score a player is expected to make against the opponent, between
+// 0 and 1.
+func EloExpectedScore(rating uint64, opponentRating uint64) sdk.Dec {
+	difference := int64(opponentRating) - int64(rating)
+	ahead := difference < 0
+	if ahead {
+		difference = -difference
+	}
+	if EloMaxDifference < difference {
+		difference = EloMaxDifference
+	}
+	odds := eloStep.Power(uint64(difference))
+	expected := sdk.OneDec().Quo(sdk.OneDec().Add(odds))
+	if ahead {
+		return sdk.OneDec().Sub(expected)
+	}
+	return expected
+}
+
+// NewEloRating returns the rating of a player after a game against the opponent, where the
+// score is 1 for a win, 0.5 for a draw and 0 for a loss.
+func NewEloRating(rating uint64, opponentRating uint64, score sdk.Dec) uint64 {
+	change := score.Sub(EloExpectedScore(rating, opponentRating)).MulInt64(EloKFactor).RoundInt64()
+	if change < 0 && rating < uint64(-change) {
+		return 0
+	}
+	return uint64(int64(rating) + change)
+}
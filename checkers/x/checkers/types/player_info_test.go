@@ -0,0 +1,41 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEloExpectedScoreEqualRatings(t *testing.T) {
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), types.EloExpectedScore(1200, 1200))
+}
+
+func TestEloExpectedScore400Ahead(t *testing.T) {
+	require.Equal(t, "0.909", types.EloExpectedScore(1600, 1200).String()[:5])
+	require.Equal(t, "0.090", types.EloExpectedScore(1200, 1600).String()[:5])
+}
+
+func TestEloExpectedScoreDifferenceCapped(t *testing.T) {
+	require.Equal(t, types.EloExpectedScore(1600, 1200), types.EloExpectedScore(2400, 1200))
+}
+
+func TestNewEloRatingWin(t *testing.T) {
+	require.EqualValues(t, 1216, types.NewEloRating(1200, 1200, sdk.OneDec()))
+	require.EqualValues(t, 1184, types.NewEloRating(1200, 1200, sdk.ZeroDec()))
+}
+
+func TestNewEloRatingDraw(t *testing.T) {
+	require.EqualValues(t, 1200, types.NewEloRating(1200, 1200, sdk.NewDecWithPrec(5, 1)))
+	require.EqualValues(t, 1213, types.NewEloRating(1200, 1600, sdk.NewDecWithPrec(5, 1)))
+	require.EqualValues(t, 1587, types.NewEloRating(1600, 1200, sdk.NewDecWithPrec(5, 1)))
+}
+
+func TestNewEloRatingUpsetWin(t *testing.T) {
+	require.EqualValues(t, 1229, types.NewEloRating(1200, 1600, sdk.OneDec()))
+}
+
+func TestNewEloRatingNotBelowZero(t *testing.T) {
+	require.EqualValues(t, 0, types.NewEloRating(10, 10, sdk.ZeroDec()))
+}
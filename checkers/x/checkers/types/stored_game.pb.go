@@ -5,16 +5,21 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,17 +28,49 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type StoredGame struct {
-	Index       string `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
-	Board       string `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
-	Turn        string `protobuf:"bytes,3,opt,name=turn,proto3" json:"turn,omitempty"`
-	Black       string `protobuf:"bytes,4,opt,name=black,proto3" json:"black,omitempty"`
-	Red         string `protobuf:"bytes,5,opt,name=red,proto3" json:"red,omitempty"`
-	MoveCount   uint64 `protobuf:"varint,6,opt,name=moveCount,proto3" json:"moveCount,omitempty"`
-	BeforeIndex string `protobuf:"bytes,7,opt,name=beforeIndex,proto3" json:"beforeIndex,omitempty"`
-	AfterIndex  string `protobuf:"bytes,8,opt,name=afterIndex,proto3" json:"afterIndex,omitempty"`
-	Deadline    string `protobuf:"bytes,9,opt,name=deadline,proto3" json:"deadline,omitempty"`
-	Winner      string `protobuf:"bytes,10,opt,name=winner,proto3" json:"winner,omitempty"`
-	Wager       uint64 `protobuf:"varint,11,opt,name=wager,proto3" json:"wager,omitempty"`
+	Index                string   `protobuf:"bytes,1,opt,name=index,proto3" json:"index,omitempty"`
+	Board                string   `protobuf:"bytes,2,opt,name=board,proto3" json:"board,omitempty"`
+	Turn                 string   `protobuf:"bytes,3,opt,name=turn,proto3" json:"turn,omitempty"`
+	Black                string   `protobuf:"bytes,4,opt,name=black,proto3" json:"black,omitempty"`
+	Red                  string   `protobuf:"bytes,5,opt,name=red,proto3" json:"red,omitempty"`
+	MoveCount            uint64   `protobuf:"varint,6,opt,name=moveCount,proto3" json:"moveCount,omitempty"`
+	Deadline             string   `protobuf:"bytes,9,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	Winner               string   `protobuf:"bytes,10,opt,name=winner,proto3" json:"winner,omitempty"`
+	Wager                uint64   `protobuf:"varint,11,opt,name=wager,proto3" json:"wager,omitempty"`
+	DrawOffer            string   `protobuf:"bytes,12,opt,name=drawOffer,proto3" json:"drawOffer,omitempty"`
+	PositionHistory      []string `protobuf:"bytes,13,rep,name=positionHistory,proto3" json:"positionHistory,omitempty"`
+	MovesWithoutProgress uint64   `protobuf:"varint,14,opt,name=movesWithoutProgress,proto3" json:"movesWithoutProgress,omitempty"`
+	Variant              string   `protobuf:"bytes,15,opt,name=variant,proto3" json:"variant,omitempty"`
+	Fen                  string   `protobuf:"bytes,16,opt,name=fen,proto3" json:"fen,omitempty"`
+	Denom                string   `protobuf:"bytes,17,opt,name=denom,proto3" json:"denom,omitempty"`
+	// One of pending, until the opponent accepts and escrows their stake, active or finished.
+	Status      string      `protobuf:"bytes,18,opt,name=status,proto3" json:"status,omitempty"`
+	Creator     string      `protobuf:"bytes,19,opt,name=creator,proto3" json:"creator,omitempty"`
+	TimeControl TimeControl `protobuf:"bytes,20,opt,name=timeControl,proto3" json:"timeControl"`
+	// What is left on each clock at the start of the player's turn, with clock time controls.
+	BlackClock time.Duration `protobuf:"bytes,21,opt,name=blackClock,proto3,stdduration" json:"blackClock"`
+	RedClock   time.Duration `protobuf:"bytes,22,opt,name=redClock,proto3,stdduration" json:"redClock"`
+	// The tournament the game was paired for, if any.
+	TournamentIndex string `protobuf:"bytes,23,opt,name=tournamentIndex,proto3" json:"tournamentIndex,omitempty"`
+	// Whether the game was won because the loser ran out of time.
+	Forfeited bool `protobuf:"varint,24,opt,name=forfeited,proto3" json:"forfeited,omitempty"`
+	// The color of the player whose takeback request is pending, if any, and how many plies they
+	// asked to take back.
+	TakebackRequest string `protobuf:"bytes,25,opt,name=takebackRequest,proto3" json:"takebackRequest,omitempty"`
+	TakebackPlies   uint64 `protobuf:"varint,26,opt,name=takebackPlies,proto3" json:"takebackPlies,omitempty"`
+	// How many takebacks were agreed on so far.
+	TakebackCount uint64 `protobuf:"varint,27,opt,name=takebackCount,proto3" json:"takebackCount,omitempty"`
+	// The color of the player whose rematch offer is pending, if any, once the game is finished.
+	RematchOffer string `protobuf:"bytes,28,opt,name=rematchOffer,proto3" json:"rematchOffer,omitempty"`
+	// The game that was played as a rematch of this one, with colors swapped, and the game this one
+	// is a rematch of.
+	RematchGame  string `protobuf:"bytes,29,opt,name=rematchGame,proto3" json:"rematchGame,omitempty"`
+	PreviousGame string `protobuf:"bytes,30,opt,name=previousGame,proto3" json:"previousGame,omitempty"`
+	// The PDN square of the piece in the middle of a multi-jump, which has to finish capturing
+	// before anything else moves, or 0.
+	CapturingSquare uint64 `protobuf:"varint,31,opt,name=capturingSquare,proto3" json:"capturingSquare,omitempty"`
+	// The PDN squares of the pieces it captured so far, which it can neither cross nor capture again.
+	CapturedSquares []uint64 `protobuf:"varint,32,rep,packed,name=capturedSquares,proto3" json:"capturedSquares,omitempty"`
 }
 
 func (m *StoredGame) Reset()         { *m = StoredGame{} }
@@ -111,41 +148,174 @@ func (m *StoredGame) GetMoveCount() uint64 {
 	return 0
 }
 
-func (m *StoredGame) GetBeforeIndex() string {
+func (m *StoredGame) GetDeadline() string {
 	if m != nil {
-		return m.BeforeIndex
+		return m.Deadline
 	}
 	return ""
 }
 
-func (m *StoredGame) GetAfterIndex() string {
+func (m *StoredGame) GetWinner() string {
 	if m != nil {
-		return m.AfterIndex
+		return m.Winner
 	}
 	return ""
 }
 
-func (m *StoredGame) GetDeadline() string {
+func (m *StoredGame) GetWager() uint64 {
 	if m != nil {
-		return m.Deadline
+		return m.Wager
+	}
+	return 0
+}
+
+func (m *StoredGame) GetDrawOffer() string {
+	if m != nil {
+		return m.DrawOffer
 	}
 	return ""
 }
 
-func (m *StoredGame) GetWinner() string {
+func (m *StoredGame) GetPositionHistory() []string {
 	if m != nil {
-		return m.Winner
+		return m.PositionHistory
+	}
+	return nil
+}
+
+func (m *StoredGame) GetMovesWithoutProgress() uint64 {
+	if m != nil {
+		return m.MovesWithoutProgress
+	}
+	return 0
+}
+
+func (m *StoredGame) GetVariant() string {
+	if m != nil {
+		return m.Variant
 	}
 	return ""
 }
 
-func (m *StoredGame) GetWager() uint64 {
+func (m *StoredGame) GetFen() string {
 	if m != nil {
-		return m.Wager
+		return m.Fen
+	}
+	return ""
+}
+
+func (m *StoredGame) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *StoredGame) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *StoredGame) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *StoredGame) GetTimeControl() TimeControl {
+	if m != nil {
+		return m.TimeControl
+	}
+	return TimeControl{}
+}
+
+func (m *StoredGame) GetBlackClock() time.Duration {
+	if m != nil {
+		return m.BlackClock
+	}
+	return 0
+}
+
+func (m *StoredGame) GetRedClock() time.Duration {
+	if m != nil {
+		return m.RedClock
+	}
+	return 0
+}
+
+func (m *StoredGame) GetTournamentIndex() string {
+	if m != nil {
+		return m.TournamentIndex
+	}
+	return ""
+}
+
+func (m *StoredGame) GetForfeited() bool {
+	if m != nil {
+		return m.Forfeited
+	}
+	return false
+}
+
+func (m *StoredGame) GetTakebackRequest() string {
+	if m != nil {
+		return m.TakebackRequest
+	}
+	return ""
+}
+
+func (m *StoredGame) GetTakebackPlies() uint64 {
+	if m != nil {
+		return m.TakebackPlies
+	}
+	return 0
+}
+
+func (m *StoredGame) GetTakebackCount() uint64 {
+	if m != nil {
+		return m.TakebackCount
+	}
+	return 0
+}
+
+func (m *StoredGame) GetRematchOffer() string {
+	if m != nil {
+		return m.RematchOffer
+	}
+	return ""
+}
+
+func (m *StoredGame) GetRematchGame() string {
+	if m != nil {
+		return m.RematchGame
+	}
+	return ""
+}
+
+func (m *StoredGame) GetPreviousGame() string {
+	if m != nil {
+		return m.PreviousGame
+	}
+	return ""
+}
+
+func (m *StoredGame) GetCapturingSquare() uint64 {
+	if m != nil {
+		return m.CapturingSquare
 	}
 	return 0
 }
 
+func (m *StoredGame) GetCapturedSquares() []uint64 {
+	if m != nil {
+		return m.CapturedSquares
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StoredGame)(nil), "alice.checkers.checkers.StoredGame")
 }
@@ -153,26 +323,49 @@ func init() {
 func init() { proto.RegisterFile("checkers/stored_game.proto", fileDescriptor_8439c9c90688ff75) }
 
 var fileDescriptor_8439c9c90688ff75 = []byte{
-	// 293 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xbf, 0x4e, 0xc3, 0x30,
-	0x10, 0xc6, 0xeb, 0xfe, 0xa3, 0xbd, 0x2e, 0xc8, 0x42, 0x60, 0x55, 0xc8, 0xaa, 0x98, 0x2a, 0x86,
-	0x76, 0xe0, 0x0d, 0x00, 0x09, 0xb1, 0x96, 0x8d, 0x05, 0x39, 0xf1, 0x35, 0x8d, 0x9a, 0xd8, 0x95,
-	0xe3, 0xd0, 0xf2, 0x16, 0x3c, 0x01, 0xcf, 0xc3, 0xd8, 0x91, 0x11, 0x25, 0x2f, 0x82, 0x72, 0x29,
-	0x69, 0xb7, 0xef, 0xfb, 0xdd, 0xcf, 0xd2, 0xc9, 0x07, 0xe3, 0x70, 0x85, 0xe1, 0x1a, 0x5d, 0x36,
-	0xcf, 0xbc, 0x75, 0xa8, 0xdf, 0x22, 0x95, 0xe2, 0x6c, 0xe3, 0xac, 0xb7, 0xfc, 0x4a, 0x25, 0x71,
-	0x88, 0xb3, 0x7f, 0xa3, 0x09, 0x37, 0x5f, 0x6d, 0x80, 0x17, 0xd2, 0x9f, 0x54, 0x8a, 0xfc, 0x02,
-	0x7a, 0xb1, 0xd1, 0xb8, 0x13, 0x6c, 0xc2, 0xa6, 0xc3, 0x45, 0x5d, 0x2a, 0x1a, 0x58, 0xe5, 0xb4,
-	0x68, 0xd7, 0x94, 0x0a, 0xe7, 0xd0, 0xf5, 0xb9, 0x33, 0xa2, 0x43, 0x90, 0x32, 0x99, 0x89, 0x0a,
-	0xd7, 0xa2, 0x7b, 0x30, 0xab, 0xc2, 0xcf, 0xa1, 0xe3, 0x50, 0x8b, 0x1e, 0xb1, 0x2a, 0xf2, 0x6b,
-	0x18, 0xa6, 0xf6, 0x1d, 0x1f, 0x6c, 0x6e, 0xbc, 0xe8, 0x4f, 0xd8, 0xb4, 0xbb, 0x38, 0x02, 0x3e,
-	0x81, 0x51, 0x80, 0x4b, 0xeb, 0xf0, 0x99, 0x76, 0x39, 0xa3, 0x77, 0xa7, 0x88, 0x4b, 0x00, 0xb5,
-	0xf4, 0xe8, 0x6a, 0x61, 0x40, 0xc2, 0x09, 0xe1, 0x63, 0x18, 0x68, 0x54, 0x3a, 0x89, 0x0d, 0x8a,
-	0x21, 0x4d, 0x9b, 0xce, 0x2f, 0xa1, 0xbf, 0x8d, 0x8d, 0x41, 0x27, 0x80, 0x26, 0x87, 0x56, 0xed,
-	0xbe, 0x55, 0x11, 0x3a, 0x31, 0xa2, 0x7d, 0xea, 0x72, 0xff, 0xf8, 0x5d, 0x48, 0xb6, 0x2f, 0x24,
-	0xfb, 0x2d, 0x24, 0xfb, 0x2c, 0x65, 0x6b, 0x5f, 0xca, 0xd6, 0x4f, 0x29, 0x5b, 0xaf, 0xb7, 0x51,
-	0xec, 0x57, 0x79, 0x30, 0x0b, 0x6d, 0x3a, 0xa7, 0xef, 0x9d, 0x37, 0x07, 0xd8, 0x1d, 0xa3, 0xff,
-	0xd8, 0x60, 0x16, 0xf4, 0xe9, 0x0c, 0x77, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x43, 0x5d, 0xe4,
-	0xd8, 0xa4, 0x01, 0x00, 0x00,
+	// 665 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x4e, 0x1b, 0x3b,
+	0x14, 0xce, 0xdc, 0x04, 0x98, 0x38, 0x70, 0xc9, 0xf5, 0x4d, 0xc1, 0x04, 0x3a, 0x8c, 0x10, 0x8b,
+	0xa8, 0x8b, 0x89, 0x44, 0x1f, 0xa0, 0x12, 0x41, 0x6a, 0x8b, 0x2a, 0x15, 0x85, 0x4a, 0x95, 0xba,
+	0x41, 0xce, 0xcc, 0xc9, 0xc4, 0x4a, 0xc6, 0x0e, 0x1e, 0x0f, 0x3f, 0x6f, 0xd1, 0x65, 0x1f, 0xa1,
+	0x8f, 0xc2, 0x92, 0x65, 0x57, 0x6d, 0x05, 0x2f, 0x52, 0xf9, 0x38, 0xbf, 0xa8, 0x95, 0xba, 0x3b,
+	0xdf, 0xe7, 0xef, 0x7c, 0xc7, 0x3e, 0xe7, 0xcc, 0x90, 0x66, 0x3c, 0x80, 0x78, 0x08, 0x3a, 0x6f,
+	0xe7, 0x46, 0x69, 0x48, 0x2e, 0x52, 0x9e, 0x41, 0x34, 0xd6, 0xca, 0x28, 0xba, 0xcd, 0x47, 0x22,
+	0x86, 0x68, 0xaa, 0x98, 0x05, 0xcd, 0x46, 0xaa, 0x52, 0x85, 0x9a, 0xb6, 0x8d, 0x9c, 0xbc, 0x19,
+	0xa4, 0x4a, 0xa5, 0x23, 0x68, 0x23, 0xea, 0x15, 0xfd, 0x76, 0x52, 0x68, 0x6e, 0x84, 0x92, 0x93,
+	0xf3, 0xdd, 0x59, 0x29, 0x23, 0x32, 0xb8, 0x88, 0x95, 0x34, 0x5a, 0x8d, 0xdc, 0xe1, 0xc1, 0x57,
+	0x9f, 0x90, 0x73, 0xbc, 0xc1, 0x6b, 0x9e, 0x01, 0x6d, 0x90, 0x15, 0x21, 0x13, 0xb8, 0x61, 0x5e,
+	0xe8, 0xb5, 0xaa, 0x5d, 0x07, 0x2c, 0xdb, 0x53, 0x5c, 0x27, 0xec, 0x1f, 0xc7, 0x22, 0xa0, 0x94,
+	0x54, 0x4c, 0xa1, 0x25, 0x2b, 0x23, 0x89, 0x31, 0x2a, 0x47, 0x3c, 0x1e, 0xb2, 0xca, 0x44, 0x69,
+	0x01, 0xad, 0x93, 0xb2, 0x86, 0x84, 0xad, 0x20, 0x67, 0x43, 0xba, 0x47, 0xaa, 0x99, 0xba, 0x82,
+	0x8e, 0x2a, 0xa4, 0x61, 0xab, 0xa1, 0xd7, 0xaa, 0x74, 0xe7, 0x04, 0x6d, 0x12, 0x3f, 0x01, 0x9e,
+	0x8c, 0x84, 0x04, 0x56, 0xc5, 0xa4, 0x19, 0xa6, 0x5b, 0x64, 0xf5, 0x5a, 0x48, 0x09, 0x9a, 0x11,
+	0x3c, 0x99, 0x20, 0x5b, 0xf9, 0x9a, 0xa7, 0xa0, 0x59, 0x0d, 0xdd, 0x1c, 0xb0, 0x75, 0x12, 0xcd,
+	0xaf, 0xdf, 0xf7, 0xfb, 0xa0, 0xd9, 0x3a, 0x26, 0xcc, 0x09, 0xda, 0x22, 0x9b, 0x63, 0x95, 0x0b,
+	0xdb, 0xab, 0x37, 0xc2, 0xce, 0xe1, 0x96, 0x6d, 0x84, 0xe5, 0x56, 0xb5, 0xfb, 0x94, 0xa6, 0x47,
+	0xa4, 0x61, 0xaf, 0x97, 0x7f, 0x14, 0x66, 0xa0, 0x0a, 0x73, 0xa6, 0x55, 0xaa, 0x21, 0xcf, 0xd9,
+	0xbf, 0x58, 0xec, 0xb7, 0x67, 0x94, 0x91, 0xb5, 0x2b, 0xae, 0x05, 0x97, 0x86, 0x6d, 0x62, 0xe5,
+	0x29, 0xb4, 0xfd, 0xe8, 0x83, 0x64, 0x75, 0xd7, 0x8f, 0x3e, 0x60, 0xdf, 0x12, 0x90, 0x2a, 0x63,
+	0xff, 0xb9, 0xbe, 0x21, 0xb0, 0x6f, 0xcd, 0x0d, 0x37, 0x45, 0xce, 0xa8, 0x7b, 0xab, 0x43, 0xd6,
+	0x39, 0xd6, 0xc0, 0x8d, 0xd2, 0xec, 0x7f, 0xe7, 0x3c, 0x81, 0xf4, 0x1d, 0xa9, 0xd9, 0x21, 0x77,
+	0xdc, 0x8c, 0x59, 0x23, 0xf4, 0x5a, 0xb5, 0xa3, 0xc3, 0xe8, 0x0f, 0x0b, 0x15, 0x7d, 0x98, 0x6b,
+	0x8f, 0x2b, 0x77, 0xdf, 0xf7, 0x4b, 0xdd, 0xc5, 0x74, 0xda, 0x21, 0x04, 0x07, 0xd8, 0x19, 0xa9,
+	0x78, 0xc8, 0x9e, 0xa1, 0xd9, 0x4e, 0xe4, 0xd6, 0x2d, 0x9a, 0xae, 0x5b, 0x74, 0x32, 0x59, 0xb7,
+	0x63, 0xdf, 0x3a, 0x7c, 0xf9, 0xb1, 0xef, 0x75, 0x17, 0xd2, 0xe8, 0x2b, 0xe2, 0x6b, 0x48, 0x9c,
+	0xc5, 0xd6, 0xdf, 0x5b, 0xcc, 0x92, 0xec, 0x94, 0x8c, 0x2a, 0xb4, 0xe4, 0x19, 0x48, 0xf3, 0x16,
+	0xb7, 0x73, 0x1b, 0x5f, 0xfd, 0x94, 0xb6, 0xd3, 0xee, 0x2b, 0xdd, 0x07, 0x61, 0x20, 0x61, 0x2c,
+	0xf4, 0x5a, 0x7e, 0x77, 0x4e, 0xa0, 0x0f, 0x1f, 0x42, 0x8f, 0xc7, 0xc3, 0x2e, 0x5c, 0x16, 0x90,
+	0x1b, 0xb6, 0x33, 0xf1, 0x59, 0xa6, 0xe9, 0x21, 0xd9, 0x98, 0x52, 0x67, 0x23, 0x01, 0x39, 0x6b,
+	0xe2, 0x98, 0x97, 0xc9, 0x45, 0x95, 0xdb, 0xe3, 0xdd, 0x65, 0x15, 0x92, 0xf4, 0x80, 0xac, 0x6b,
+	0xc8, 0xb8, 0x89, 0x07, 0x6e, 0x09, 0xf7, 0xb0, 0xe4, 0x12, 0x47, 0x43, 0x52, 0x9b, 0x60, 0xfb,
+	0x11, 0xb2, 0xe7, 0x28, 0x59, 0xa4, 0xac, 0xcb, 0x58, 0xc3, 0x95, 0x50, 0x45, 0x8e, 0x92, 0xc0,
+	0xb9, 0x2c, 0x72, 0xf6, 0x7d, 0x31, 0x1f, 0x9b, 0x42, 0x0b, 0x99, 0x9e, 0x5f, 0x16, 0x5c, 0x03,
+	0xdb, 0xc7, 0x1b, 0x3d, 0xa5, 0xe7, 0x4a, 0x48, 0x1c, 0x93, 0xb3, 0x30, 0x2c, 0xcf, 0x95, 0x33,
+	0xfa, 0xb4, 0xe2, 0xaf, 0xd5, 0xfd, 0xd3, 0x8a, 0xef, 0xd7, 0xab, 0xc7, 0x27, 0x77, 0x0f, 0x81,
+	0x77, 0xff, 0x10, 0x78, 0x3f, 0x1f, 0x02, 0xef, 0xf3, 0x63, 0x50, 0xba, 0x7f, 0x0c, 0x4a, 0xdf,
+	0x1e, 0x83, 0xd2, 0xa7, 0x17, 0xa9, 0x30, 0x83, 0xa2, 0x17, 0xc5, 0x2a, 0x6b, 0xe3, 0xaa, 0xb5,
+	0x67, 0xbf, 0x9c, 0x9b, 0x79, 0x68, 0x6e, 0xc7, 0x90, 0xf7, 0x56, 0x71, 0xe8, 0x2f, 0x7f, 0x0d,
+	0x00, 0x76, 0x20, 0x0b, 0x31, 0x01, 0x05, 0x00, 0x00,
 }
 
 func (m *StoredGame) Marshal() (dAtA []byte, err error) {
@@ -195,6 +388,200 @@ func (m *StoredGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CapturedSquares) > 0 {
+		dAtA2 := make([]byte, len(m.CapturedSquares)*10)
+		var j1 int
+		for _, num := range m.CapturedSquares {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintStoredGame(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.CapturingSquare != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.CapturingSquare))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if len(m.PreviousGame) > 0 {
+		i -= len(m.PreviousGame)
+		copy(dAtA[i:], m.PreviousGame)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.PreviousGame)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if len(m.RematchGame) > 0 {
+		i -= len(m.RematchGame)
+		copy(dAtA[i:], m.RematchGame)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.RematchGame)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.RematchOffer) > 0 {
+		i -= len(m.RematchOffer)
+		copy(dAtA[i:], m.RematchOffer)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.RematchOffer)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.TakebackCount != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.TakebackCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if m.TakebackPlies != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.TakebackPlies))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	if len(m.TakebackRequest) > 0 {
+		i -= len(m.TakebackRequest)
+		copy(dAtA[i:], m.TakebackRequest)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.TakebackRequest)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.Forfeited {
+		i--
+		if m.Forfeited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.TournamentIndex) > 0 {
+		i -= len(m.TournamentIndex)
+		copy(dAtA[i:], m.TournamentIndex)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.TournamentIndex)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RedClock, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RedClock):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintStoredGame(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.BlackClock, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.BlackClock):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintStoredGame(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size, err := m.TimeControl.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStoredGame(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.Fen) > 0 {
+		i -= len(m.Fen)
+		copy(dAtA[i:], m.Fen)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Fen)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Variant) > 0 {
+		i -= len(m.Variant)
+		copy(dAtA[i:], m.Variant)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.Variant)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.MovesWithoutProgress != 0 {
+		i = encodeVarintStoredGame(dAtA, i, uint64(m.MovesWithoutProgress))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.PositionHistory) > 0 {
+		for iNdEx := len(m.PositionHistory) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PositionHistory[iNdEx])
+			copy(dAtA[i:], m.PositionHistory[iNdEx])
+			i = encodeVarintStoredGame(dAtA, i, uint64(len(m.PositionHistory[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.DrawOffer) > 0 {
+		i -= len(m.DrawOffer)
+		copy(dAtA[i:], m.DrawOffer)
+		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.DrawOffer)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.Wager != 0 {
 		i = encodeVarintStoredGame(dAtA, i, uint64(m.Wager))
 		i--
@@ -214,20 +601,6 @@ func (m *StoredGame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x4a
 	}
-	if len(m.AfterIndex) > 0 {
-		i -= len(m.AfterIndex)
-		copy(dAtA[i:], m.AfterIndex)
-		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.AfterIndex)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.BeforeIndex) > 0 {
-		i -= len(m.BeforeIndex)
-		copy(dAtA[i:], m.BeforeIndex)
-		i = encodeVarintStoredGame(dAtA, i, uint64(len(m.BeforeIndex)))
-		i--
-		dAtA[i] = 0x3a
-	}
 	if m.MoveCount != 0 {
 		i = encodeVarintStoredGame(dAtA, i, uint64(m.MoveCount))
 		i--
@@ -311,24 +684,94 @@ func (m *StoredGame) Size() (n int) {
 	if m.MoveCount != 0 {
 		n += 1 + sovStoredGame(uint64(m.MoveCount))
 	}
-	l = len(m.BeforeIndex)
+	l = len(m.Deadline)
 	if l > 0 {
 		n += 1 + l + sovStoredGame(uint64(l))
 	}
-	l = len(m.AfterIndex)
+	l = len(m.Winner)
 	if l > 0 {
 		n += 1 + l + sovStoredGame(uint64(l))
 	}
-	l = len(m.Deadline)
+	if m.Wager != 0 {
+		n += 1 + sovStoredGame(uint64(m.Wager))
+	}
+	l = len(m.DrawOffer)
 	if l > 0 {
 		n += 1 + l + sovStoredGame(uint64(l))
 	}
-	l = len(m.Winner)
+	if len(m.PositionHistory) > 0 {
+		for _, s := range m.PositionHistory {
+			l = len(s)
+			n += 1 + l + sovStoredGame(uint64(l))
+		}
+	}
+	if m.MovesWithoutProgress != 0 {
+		n += 1 + sovStoredGame(uint64(m.MovesWithoutProgress))
+	}
+	l = len(m.Variant)
 	if l > 0 {
 		n += 1 + l + sovStoredGame(uint64(l))
 	}
-	if m.Wager != 0 {
-		n += 1 + sovStoredGame(uint64(m.Wager))
+	l = len(m.Fen)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = m.TimeControl.Size()
+	n += 2 + l + sovStoredGame(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.BlackClock)
+	n += 2 + l + sovStoredGame(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RedClock)
+	n += 2 + l + sovStoredGame(uint64(l))
+	l = len(m.TournamentIndex)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	if m.Forfeited {
+		n += 3
+	}
+	l = len(m.TakebackRequest)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	if m.TakebackPlies != 0 {
+		n += 2 + sovStoredGame(uint64(m.TakebackPlies))
+	}
+	if m.TakebackCount != 0 {
+		n += 2 + sovStoredGame(uint64(m.TakebackCount))
+	}
+	l = len(m.RematchOffer)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.RematchGame)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	l = len(m.PreviousGame)
+	if l > 0 {
+		n += 2 + l + sovStoredGame(uint64(l))
+	}
+	if m.CapturingSquare != 0 {
+		n += 2 + sovStoredGame(uint64(m.CapturingSquare))
+	}
+	if len(m.CapturedSquares) > 0 {
+		l = 0
+		for _, e := range m.CapturedSquares {
+			l += sovStoredGame(uint64(e))
+		}
+		n += 2 + sovStoredGame(uint64(l)) + l
 	}
 	return n
 }
@@ -547,9 +990,9 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BeforeIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -577,11 +1020,11 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BeforeIndex = string(dAtA[iNdEx:postIndex])
+			m.Deadline = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -609,11 +1052,30 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AfterIndex = string(dAtA[iNdEx:postIndex])
+			m.Winner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
+			}
+			m.Wager = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Wager |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawOffer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -641,11 +1103,11 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deadline = string(dAtA[iNdEx:postIndex])
+			m.DrawOffer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionHistory", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -673,13 +1135,13 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Winner = string(dAtA[iNdEx:postIndex])
+			m.PositionHistory = append(m.PositionHistory, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 11:
+		case 14:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Wager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MovesWithoutProgress", wireType)
 			}
-			m.Wager = 0
+			m.MovesWithoutProgress = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStoredGame
@@ -689,11 +1151,583 @@ func (m *StoredGame) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Wager |= uint64(b&0x7F) << shift
+				m.MovesWithoutProgress |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Variant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fen", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fen = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TimeControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlackClock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.BlackClock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedClock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RedClock, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TournamentIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TournamentIndex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forfeited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Forfeited = bool(v != 0)
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakebackRequest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakebackRequest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakebackPlies", wireType)
+			}
+			m.TakebackPlies = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakebackPlies |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakebackCount", wireType)
+			}
+			m.TakebackCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakebackCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RematchOffer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RematchOffer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RematchGame", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RematchGame = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousGame", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStoredGame
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousGame = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapturingSquare", wireType)
+			}
+			m.CapturingSquare = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStoredGame
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CapturingSquare |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStoredGame
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CapturedSquares = append(m.CapturedSquares, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStoredGame
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStoredGame
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStoredGame
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CapturedSquares) == 0 {
+					m.CapturedSquares = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStoredGame
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CapturedSquares = append(m.CapturedSquares, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapturedSquares", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStoredGame(dAtA[iNdEx:])
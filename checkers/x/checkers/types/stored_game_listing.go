@@ -0,0 +1,78 @@
+package types
+
+import (
+	"strconv"
+
+	"github.com/alice/checkers/rules"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsListingStatus tells whether games can be listed by this status, the empty one standing for
+// all games.
+func IsListingStatus(status string) bool {
+	switch status {
+	case "", GameStatusPending, GameStatusActive, GameStatusFinished, GameStatusForfeited, GameStatusDrawn:
+		return true
+	}
+	return false
+}
+
+// IsListingSortBy tells whether games can be listed in this order.
+func IsListingSortBy(sortBy string) bool {
+	return sortBy == GameSortByCreated || sortBy == GameSortByDeadline
+}
+
+// GetListingStatuses returns the statuses the game is listed by, beside that of all games. A game
+// that was forfeited or drawn is listed as finished too.
+func (storedGame StoredGame) GetListingStatuses() (statuses []string) {
+	statuses = []string{storedGame.Status}
+	if storedGame.Status != GameStatusFinished {
+		return statuses
+	}
+	if storedGame.Forfeited {
+		statuses = append(statuses, GameStatusForfeited)
+	}
+	if storedGame.Winner == rules.PieceStrings[rules.DRAW_PLAYER] {
+		statuses = append(statuses, GameStatusDrawn)
+	}
+	return statuses
+}
+
+// HasListingStatus tells whether the game is listed by the status, the empty one matching all games.
+func (storedGame StoredGame) HasListingStatus(status string) bool {
+	if status == "" {
+		return true
+	}
+	for _, listingStatus := range storedGame.GetListingStatuses() {
+		if listingStatus == status {
+			return true
+		}
+	}
+	return false
+}
+
+// GetListingSortKey returns what the game sorts by in the given order, or not found when it cannot
+// be sorted so. Games are created with ever greater indices, so that they sort by creation on their
+// index as a number.
+func (storedGame StoredGame) GetListingSortKey(sortBy string) (sortKey []byte, found bool) {
+	switch sortBy {
+	case GameSortByCreated:
+		index, err := strconv.ParseUint(storedGame.Index, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		return sdk.Uint64ToBigEndian(index), true
+	case GameSortByDeadline:
+		deadline, err := storedGame.GetDeadlineAsTime()
+		if err != nil {
+			return nil, false
+		}
+		return sdk.FormatTimeBytes(deadline), true
+	}
+	return nil, false
+}
+
+// GetListingTurns returns the turns the game is listed by, the empty one matching either color.
+func (storedGame StoredGame) GetListingTurns() []string {
+	return []string{"", storedGame.Turn}
+}
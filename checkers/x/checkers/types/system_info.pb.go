@@ -23,9 +23,7 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type SystemInfo struct {
-	NextId        uint64 `protobuf:"varint,1,opt,name=nextId,proto3" json:"nextId,omitempty"`
-	FifoHeadIndex string `protobuf:"bytes,2,opt,name=fifoHeadIndex,proto3" json:"fifoHeadIndex,omitempty"`
-	FifoTailIndex string `protobuf:"bytes,3,opt,name=fifoTailIndex,proto3" json:"fifoTailIndex,omitempty"`
+	NextId uint64 `protobuf:"varint,1,opt,name=nextId,proto3" json:"nextId,omitempty"`
 }
 
 func (m *SystemInfo) Reset()         { *m = SystemInfo{} }
@@ -68,20 +66,6 @@ func (m *SystemInfo) GetNextId() uint64 {
 	return 0
 }
 
-func (m *SystemInfo) GetFifoHeadIndex() string {
-	if m != nil {
-		return m.FifoHeadIndex
-	}
-	return ""
-}
-
-func (m *SystemInfo) GetFifoTailIndex() string {
-	if m != nil {
-		return m.FifoTailIndex
-	}
-	return ""
-}
-
 func init() {
 	proto.RegisterType((*SystemInfo)(nil), "alice.checkers.checkers.SystemInfo")
 }
@@ -89,19 +73,18 @@ func init() {
 func init() { proto.RegisterFile("checkers/system_info.proto", fileDescriptor_1580c0dd88c0be2b) }
 
 var fileDescriptor_1580c0dd88c0be2b = []byte{
-	// 191 bytes of a gzipped FileDescriptorProto
+	// 161 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xce, 0x48, 0x4d,
 	0xce, 0x4e, 0x2d, 0x2a, 0xd6, 0x2f, 0xae, 0x2c, 0x2e, 0x49, 0xcd, 0x8d, 0xcf, 0xcc, 0x4b, 0xcb,
 	0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x4f, 0xcc, 0xc9, 0x4c, 0x4e, 0xd5, 0x83, 0xa9,
-	0x80, 0x33, 0x94, 0x0a, 0xb8, 0xb8, 0x82, 0xc1, 0xaa, 0x3d, 0xf3, 0xd2, 0xf2, 0x85, 0xc4, 0xb8,
+	0x80, 0x33, 0x94, 0x0c, 0xb8, 0xb8, 0x82, 0xc1, 0xaa, 0x3d, 0xf3, 0xd2, 0xf2, 0x85, 0xc4, 0xb8,
 	0xd8, 0xf2, 0x52, 0x2b, 0x4a, 0x3c, 0x53, 0x24, 0x18, 0x15, 0x18, 0x35, 0x58, 0x82, 0xa0, 0x3c,
-	0x21, 0x15, 0x2e, 0xde, 0xb4, 0xcc, 0xb4, 0x7c, 0x8f, 0xd4, 0xc4, 0x14, 0xcf, 0xbc, 0x94, 0xd4,
-	0x0a, 0x09, 0x26, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x54, 0x41, 0x98, 0xaa, 0x90, 0xc4, 0xcc, 0x1c,
-	0x88, 0x2a, 0x66, 0x84, 0x2a, 0xb8, 0xa0, 0x93, 0xcb, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9,
-	0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e,
-	0xcb, 0x31, 0x44, 0x69, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x83,
-	0xdd, 0xab, 0x0f, 0xf7, 0x51, 0x05, 0x82, 0x59, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xf6,
-	0x97, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x8a, 0x94, 0x2d, 0x63, 0xf5, 0x00, 0x00, 0x00,
+	0x2f, 0x16, 0x0e, 0x26, 0x01, 0x66, 0x2f, 0x16, 0x0e, 0x66, 0x01, 0x16, 0x27, 0x97, 0x13, 0x8f,
+	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
+	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
+	0x4b, 0xce, 0xcf, 0xd5, 0x07, 0xdb, 0xa7, 0x0f, 0x77, 0x51, 0x05, 0x82, 0x59, 0x52, 0x59, 0x90,
+	0x5a, 0x9c, 0xc4, 0x06, 0x76, 0x97, 0x31, 0x60, 0x00, 0x8b, 0xab, 0xa6, 0x47, 0xb5, 0x00, 0x00,
+	0x00,
 }
 
 func (m *SystemInfo) Marshal() (dAtA []byte, err error) {
@@ -124,20 +107,6 @@ func (m *SystemInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.FifoTailIndex) > 0 {
-		i -= len(m.FifoTailIndex)
-		copy(dAtA[i:], m.FifoTailIndex)
-		i = encodeVarintSystemInfo(dAtA, i, uint64(len(m.FifoTailIndex)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.FifoHeadIndex) > 0 {
-		i -= len(m.FifoHeadIndex)
-		copy(dAtA[i:], m.FifoHeadIndex)
-		i = encodeVarintSystemInfo(dAtA, i, uint64(len(m.FifoHeadIndex)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if m.NextId != 0 {
 		i = encodeVarintSystemInfo(dAtA, i, uint64(m.NextId))
 		i--
@@ -166,14 +135,6 @@ func (m *SystemInfo) Size() (n int) {
 	if m.NextId != 0 {
 		n += 1 + sovSystemInfo(uint64(m.NextId))
 	}
-	l = len(m.FifoHeadIndex)
-	if l > 0 {
-		n += 1 + l + sovSystemInfo(uint64(l))
-	}
-	l = len(m.FifoTailIndex)
-	if l > 0 {
-		n += 1 + l + sovSystemInfo(uint64(l))
-	}
 	return n
 }
 
@@ -231,70 +192,6 @@ func (m *SystemInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FifoHeadIndex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSystemInfo
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSystemInfo
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSystemInfo
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FifoHeadIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FifoTailIndex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowSystemInfo
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthSystemInfo
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthSystemInfo
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FifoTailIndex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSystemInfo(dAtA[iNdEx:])
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// IsZero tells whether no time control was chosen, in which case the max turn duration param applies.
+func (timeControl TimeControl) IsZero() bool {
+	return timeControl.TurnDuration == 0 && timeControl.Clock == 0 && timeControl.Increment == 0
+}
+
+// HasClock tells whether players have a total time for the game instead of a limit on each move.
+func (timeControl TimeControl) HasClock() bool {
+	return timeControl.Clock != 0
+}
+
+// Validate checks that there is either a limit on each move or a clock, and that only a clock
+// gets an increment.
+func (timeControl TimeControl) Validate() error {
+	if timeControl.TurnDuration < 0 || timeControl.Clock < 0 || timeControl.Increment < 0 {
+		return sdkerrors.Wrapf(ErrInvalidTimeControl, "negative duration")
+	}
+	if timeControl.TurnDuration != 0 && timeControl.Clock != 0 {
+		return sdkerrors.Wrapf(ErrInvalidTimeControl, "both a turn duration and a clock")
+	}
+	if timeControl.Increment != 0 && timeControl.Clock == 0 {
+		return sdkerrors.Wrapf(ErrInvalidTimeControl, "increment without a clock")
+	}
+	return nil
+}
+
+// CheckBounds checks the turn duration or the clock against the params, and that the increment
+// is not longer than the longest time control.
+func (timeControl TimeControl) CheckBounds(min time.Duration, max time.Duration) error {
+	limit := timeControl.TurnDuration
+	if timeControl.HasClock() {
+		limit = timeControl.Clock
+	}
+	if limit < min || max < limit {
+		return sdkerrors.Wrapf(ErrTimeControlOutOfBounds, "%s not within %s and %s", limit, min, max)
+	}
+	if max < timeControl.Increment {
+		return sdkerrors.Wrapf(ErrTimeControlOutOfBounds, "increment %s longer than %s", timeControl.Increment, max)
+	}
+	return nil
+}
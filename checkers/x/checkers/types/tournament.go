@@ -0,0 +1,201 @@
+package types
+
+import (
+	"sort"
+	"time"
+
+	"github.com/alice/checkers/rules"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (tournament Tournament) GetStartTimeAsTime() (startTime time.Time, err error) {
+	startTime, errStartTime := time.Parse(DeadlineLayout, tournament.StartTime)
+	return startTime, sdkerrors.Wrapf(errStartTime, ErrInvalidStartTime.Error(), tournament.StartTime)
+}
+
+func (tournament Tournament) GetEntryFeeCoin() (entryFee sdk.Coin) {
+	return sdk.NewCoin(WagerDenom(tournament.Denom), sdk.NewIntFromUint64(tournament.EntryFee))
+}
+
+// GetPrizePool returns the sum of the entry fees paid.
+func (tournament Tournament) GetPrizePool() (pool sdk.Coin) {
+	return sdk.NewCoin(WagerDenom(tournament.Denom), sdk.NewIntFromUint64(tournament.EntryFee).MulRaw(int64(len(tournament.Players))))
+}
+
+func (tournament Tournament) HasPlayer(address string) bool {
+	return tournament.seed(address) < len(tournament.Players)
+}
+
+// The seed of a player is their place in the join order, the first to join is the top seed.
+func (tournament Tournament) seed(address string) int {
+	for i, player := range tournament.Players {
+		if player == address {
+			return i
+		}
+	}
+	return len(tournament.Players)
+}
+
+// IsRoundOver tells whether all the games of the current round have a result.
+func (tournament Tournament) IsRoundOver() bool {
+	for _, game := range tournament.Games {
+		if game.Round == tournament.Round && game.Winner == rules.PieceStrings[rules.NO_PLAYER] {
+			return false
+		}
+	}
+	return true
+}
+
+// Survivors returns the players still in a single elimination tournament after the current round,
+// in bracket order. A draw eliminates the lower seed.
+func (tournament Tournament) Survivors() (survivors []string) {
+	if tournament.Round == 0 {
+		return tournament.Players
+	}
+	for _, game := range tournament.Games {
+		if game.Round != tournament.Round {
+			continue
+		}
+		switch game.Winner {
+		case rules.PieceStrings[rules.BLACK_PLAYER]:
+			survivors = append(survivors, game.Black)
+		case rules.PieceStrings[rules.RED_PLAYER]:
+			survivors = append(survivors, game.Red)
+		default:
+			if tournament.seed(game.Black) < tournament.seed(game.Red) {
+				survivors = append(survivors, game.Black)
+			} else {
+				survivors = append(survivors, game.Red)
+			}
+		}
+	}
+	return survivors
+}
+
+// RoundCount returns how many rounds a round robin tournament has, one less than the players, or
+// as many as them when one of them sits out each round.
+func (tournament Tournament) RoundCount() uint64 {
+	count := uint64(len(tournament.Players))
+	if count%2 == 0 {
+		count--
+	}
+	return count
+}
+
+func newTournamentGame(round uint64, black string, red string) TournamentGame {
+	if black == "" {
+		black, red = red, black
+	}
+	winner := rules.PieceStrings[rules.NO_PLAYER]
+	if red == "" {
+		winner = rules.PieceStrings[rules.BLACK_PLAYER]
+	}
+	return TournamentGame{
+		Round:  round,
+		Black:  black,
+		Red:    red,
+		Winner: winner,
+	}
+}
+
+// NextRound returns the games of the round after the current one, byes included, or done when the
+// tournament is over. Their game indices are left for the caller to fill in.
+//
+// In single elimination, the survivors play the next one in the bracket and the top survivor gets a
+// bye when they are odd. In round robin, players are paired with the circle method, so that each
+// of them meets all the others once, and colors swap every other round.
+func (tournament Tournament) NextRound() (games []TournamentGame, done bool) {
+	round := tournament.Round + 1
+	if tournament.Format == TournamentFormatSingleElimination {
+		survivors := tournament.Survivors()
+		if len(survivors) <= 1 {
+			return nil, true
+		}
+		if len(survivors)%2 == 1 {
+			games = append(games, newTournamentGame(round, survivors[0], ""))
+			survivors = survivors[1:]
+		}
+		for i := 0; i < len(survivors); i += 2 {
+			games = append(games, newTournamentGame(round, survivors[i], survivors[i+1]))
+		}
+		return games, false
+	}
+
+	if tournament.RoundCount() < round {
+		return nil, true
+	}
+	players := append([]string{}, tournament.Players...)
+	if len(players)%2 == 1 {
+		players = append(players, "")
+	}
+	// The first player stays in place while the others turn around them by one seat each round.
+	count := len(players)
+	circle := []string{players[0]}
+	for i := 1; i < count; i++ {
+		circle = append(circle, players[1+(i-1+int(round)-1)%(count-1)])
+	}
+	for i := 0; i < count/2; i++ {
+		black, red := circle[i], circle[count-1-i]
+		if round%2 == 0 {
+			black, red = red, black
+		}
+		games = append(games, newTournamentGame(round, black, red))
+	}
+	return games, false
+}
+
+// Standings returns the record of each player, the most points first and then by seed.
+func (tournament Tournament) Standings() (standings []TournamentStanding) {
+	standings = make([]TournamentStanding, len(tournament.Players))
+	for i, player := range tournament.Players {
+		standings[i].Player = player
+	}
+	for _, game := range tournament.Games {
+		black := &standings[tournament.seed(game.Black)]
+		if game.Red == "" {
+			black.Points += TournamentWinPoints
+			continue
+		}
+		if game.Winner == rules.PieceStrings[rules.NO_PLAYER] {
+			continue
+		}
+		red := &standings[tournament.seed(game.Red)]
+		black.PlayedCount++
+		red.PlayedCount++
+		switch game.Winner {
+		case rules.PieceStrings[rules.BLACK_PLAYER]:
+			black.WonCount++
+			black.Points += TournamentWinPoints
+			red.LostCount++
+		case rules.PieceStrings[rules.RED_PLAYER]:
+			red.WonCount++
+			red.Points += TournamentWinPoints
+			black.LostCount++
+		default:
+			black.DrawnCount++
+			black.Points += TournamentDrawPoints
+			red.DrawnCount++
+			red.Points += TournamentDrawPoints
+		}
+	}
+	sort.SliceStable(standings, func(i, j int) bool {
+		return standings[i].Points > standings[j].Points
+	})
+	return standings
+}
+
+// Champions returns those who share the prize of a tournament that is over, by seed. It is the last
+// survivor in single elimination and those tied with the most points in round robin.
+func (tournament Tournament) Champions() (champions []string) {
+	if tournament.Format == TournamentFormatSingleElimination {
+		return tournament.Survivors()
+	}
+	standings := tournament.Standings()
+	for _, standing := range standings {
+		if standing.Points == standings[0].Points {
+			champions = append(champions, standing.Player)
+		}
+	}
+	return champions
+}
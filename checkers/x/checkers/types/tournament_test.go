@@ -0,0 +1,190 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/alice/checkers/testutil/sample"
+	"github.com/alice/checkers/x/checkers/testutil"
+	"github.com/alice/checkers/x/checkers/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	carol = testutil.Carol
+	dave  = sample.AccAddress()
+)
+
+// Plays the current round, the games won by the given colors in order.
+func playRound(tournament *types.Tournament, winners ...string) {
+	games, done := tournament.NextRound()
+	if done {
+		panic("tournament is over")
+	}
+	tournament.Round++
+	for i := range games {
+		if games[i].Red != "" {
+			games[i].Winner = winners[0]
+			winners = winners[1:]
+		}
+	}
+	tournament.Games = append(tournament.Games, games...)
+}
+
+func TestSingleEliminationPairsInJoinOrder(t *testing.T) {
+	tournament := types.Tournament{
+		Format:  types.TournamentFormatSingleElimination,
+		Players: []string{alice, bob, carol, dave},
+	}
+	games, done := tournament.NextRound()
+	require.False(t, done)
+	require.EqualValues(t, []types.TournamentGame{
+		{Round: 1, Black: alice, Red: bob, Winner: "*"},
+		{Round: 1, Black: carol, Red: dave, Winner: "*"},
+	}, games)
+}
+
+func TestSingleEliminationWinnersMeet(t *testing.T) {
+	tournament := types.Tournament{
+		Format:  types.TournamentFormatSingleElimination,
+		Players: []string{alice, bob, carol, dave},
+	}
+	playRound(&tournament, "r", "b")
+	require.Equal(t, []string{bob, carol}, tournament.Survivors())
+	games, done := tournament.NextRound()
+	require.False(t, done)
+	require.EqualValues(t, []types.TournamentGame{
+		{Round: 2, Black: bob, Red: carol, Winner: "*"},
+	}, games)
+	playRound(&tournament, "r")
+	_, done = tournament.NextRound()
+	require.True(t, done)
+	require.Equal(t, []string{carol}, tournament.Champions())
+}
+
+func TestSingleEliminationTopSeedGetsBye(t *testing.T) {
+	tournament := types.Tournament{
+		Format:  types.TournamentFormatSingleElimination,
+		Players: []string{alice, bob, carol},
+	}
+	games, _ := tournament.NextRound()
+	require.EqualValues(t, []types.TournamentGame{
+		{Round: 1, Black: alice, Winner: "b"},
+		{Round: 1, Black: bob, Red: carol, Winner: "*"},
+	}, games)
+	playRound(&tournament, "r")
+	require.Equal(t, []string{alice, carol}, tournament.Survivors())
+}
+
+func TestSingleEliminationDrawAdvancesTopSeed(t *testing.T) {
+	tournament := types.Tournament{
+		Format:  types.TournamentFormatSingleElimination,
+		Players: []string{alice, bob},
+		Round:   1,
+		Games: []types.TournamentGame{
+			{Round: 1, Black: bob, Red: alice, Winner: "d"},
+		},
+	}
+	require.Equal(t, []string{alice}, tournament.Survivors())
+}
+
+func TestRoundRobinMeetsEveryoneOnce(t *testing.T) {
+	tournament := types.Tournament{
+		Format:  types.TournamentFormatRoundRobin,
+		Players: []string{alice, bob, carol, dave},
+	}
+	require.EqualValues(t, 3, tournament.RoundCount())
+	met := map[[2]string]int{}
+	for round := 0; round < 3; round++ {
+		games, done := tournament.NextRound()
+		require.False(t, done)
+		require.Len(t, games, 2)
+		for _, game := range games {
+			pair := [2]string{game.Black, game.Red}
+			if pair[1] < pair[0] {
+				pair = [2]string{game.Red, game.Black}
+			}
+			met[pair]++
+		}
+		playRound(&tournament, "b", "b")
+	}
+	require.Len(t, met, 6)
+	for _, count := range met {
+		require.Equal(t, 1, count)
+	}
+	_, done := tournament.NextRound()
+	require.True(t, done)
+}
+
+func TestRoundRobinOddSitsOutOnce(t *testing.T) {
+	tournament := types.Tournament{
+		Format:  types.TournamentFormatRoundRobin,
+		Players: []string{alice, bob, carol},
+	}
+	require.EqualValues(t, 3, tournament.RoundCount())
+	byes := map[string]int{}
+	for round := 0; round < 3; round++ {
+		games, _ := tournament.NextRound()
+		require.Len(t, games, 2)
+		for _, game := range games {
+			if game.Red == "" {
+				byes[game.Black]++
+			}
+		}
+		playRound(&tournament, "d")
+	}
+	require.Equal(t, map[string]int{alice: 1, bob: 1, carol: 1}, byes)
+}
+
+func TestStandingsByPointsThenSeed(t *testing.T) {
+	tournament := types.Tournament{
+		Format:  types.TournamentFormatRoundRobin,
+		Players: []string{alice, bob, carol},
+		Round:   1,
+		Games: []types.TournamentGame{
+			{Round: 1, Black: alice, Winner: "b"},
+			{Round: 1, Black: bob, Red: carol, Winner: "r"},
+			{Round: 2, Black: alice, Red: bob, GameIndex: "2", Winner: "d"},
+			{Round: 2, Black: carol, Red: alice, GameIndex: "3", Winner: "*"},
+		},
+	}
+	require.EqualValues(t, []types.TournamentStanding{
+		{Player: alice, PlayedCount: 1, DrawnCount: 1, Points: 3},
+		{Player: carol, PlayedCount: 1, WonCount: 1, Points: 2},
+		{Player: bob, PlayedCount: 2, DrawnCount: 1, LostCount: 1, Points: 1},
+	}, tournament.Standings())
+}
+
+func TestRoundRobinChampionsShareTies(t *testing.T) {
+	tournament := types.Tournament{
+		Format:  types.TournamentFormatRoundRobin,
+		Players: []string{alice, bob, carol},
+		Games: []types.TournamentGame{
+			{Round: 1, Black: alice, Red: bob, Winner: "b"},
+			{Round: 2, Black: bob, Red: carol, Winner: "b"},
+			{Round: 3, Black: carol, Red: alice, Winner: "b"},
+		},
+	}
+	require.Equal(t, []string{alice, bob, carol}, tournament.Champions())
+}
+
+func TestPrizePoolAddsEntryFees(t *testing.T) {
+	tournament := types.Tournament{
+		EntryFee: 15,
+		Denom:    "coin",
+		Players:  []string{alice, bob, carol},
+	}
+	require.Equal(t, sdk.NewInt64Coin("coin", 45), tournament.GetPrizePool())
+	require.True(t, tournament.HasPlayer(bob))
+	require.False(t, tournament.HasPlayer(dave))
+}
+
+func TestPrizePoolDoesNotWrap(t *testing.T) {
+	tournament := types.Tournament{
+		EntryFee: math.MaxInt64,
+		Denom:    "coin",
+		Players:  []string{alice, bob},
+	}
+	require.Equal(t, "18446744073709551614coin", tournament.GetPrizePool().String())
+}